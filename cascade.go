@@ -0,0 +1,70 @@
+package multimon
+
+// DefaultCascadeStep is the cascade offset in logical units used by
+// CascadePlacement when no step is specified
+const DefaultCascadeStep = 32
+
+// CascadePlacement offsets a new window so that it does not cover existing
+// windows at the same position, as when several documents are opened in a row.
+// Starting from the given window rect (e.g. from InitialPlacement), the window
+// is moved down and right by step until its top-left corner does not coincide
+// with the top-left corner of an existing window. When the next step would
+// push the window out of the work area, the cascade wraps back to the
+// top-left corner of the work area.
+//
+// Parameters:
+// - window: initial window rect in screen units
+// - existing: rects of existing windows in screen units
+// - step: cascade offset in logical units, DefaultCascadeStep if <= 0
+//
+// Top-left corners closer than half a step are considered coinciding.
+// The window is fitted to the work area before cascading.
+// If m is nil, the window is cascaded in 1:1 scale without bounds.
+// Returns the window rect in screen units.
+func CascadePlacement(m *Monitor, window Rect, existing []Rect, step int) Rect {
+	if step <= 0 {
+		step = DefaultCascadeStep
+	}
+
+	scale := 1.0
+	if m != nil {
+		scale = m.Scale
+	}
+	screenStep := max(1, int(float64(step)*scale))
+	tolerance := max(1, screenStep/2)
+
+	width := window.Right - window.Left
+	height := window.Bottom - window.Top
+	left, top := window.Left, window.Top
+	if m != nil {
+		left, width = fitRectDimension(left, width, m.WorkArea.Left, m.WorkArea.Right)
+		top, height = fitRectDimension(top, height, m.WorkArea.Top, m.WorkArea.Bottom)
+	}
+
+	isOccupied := func(x, y int) bool {
+		for _, e := range existing {
+			if abs(e.Left-x) < tolerance && abs(e.Top-y) < tolerance {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Every step either reaches a free position or passes an existing window,
+	// so the number of steps is bounded; wrapping can revisit positions once.
+	for i := 0; i < 2*(len(existing)+1) && isOccupied(left, top); i++ {
+		left += screenStep
+		top += screenStep
+		if m != nil && (left+width > m.WorkArea.Right || top+height > m.WorkArea.Bottom) {
+			left = m.WorkArea.Left
+			top = m.WorkArea.Top
+		}
+	}
+
+	return Rect{
+		Left:   left,
+		Top:    top,
+		Right:  left + width,
+		Bottom: top + height,
+	}
+}
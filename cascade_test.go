@@ -0,0 +1,112 @@
+package multimon
+
+import "testing"
+
+func TestCascadePlacement(t *testing.T) {
+	monitor := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 40, 1920, 1040},
+		Scale:    1.5,
+	}
+	initial := Rect{460, 240, 1460, 840}
+
+	tests := []struct {
+		name      string
+		noMonitor bool
+		window    Rect
+		existing  []Rect
+		step      int
+		want      Rect
+	}{
+		{
+			name:   "no existing windows",
+			window: initial,
+			want:   initial,
+		},
+		{
+			name:     "existing window elsewhere",
+			window:   initial,
+			existing: []Rect{{0, 40, 800, 640}},
+			want:     initial,
+		},
+		{
+			name:     "one window at the same position",
+			window:   initial,
+			existing: []Rect{initial},
+			want:     Rect{508, 288, 1508, 888}, // 32 * 1.5 = 48
+		},
+		{
+			name:     "several cascaded windows",
+			window:   initial,
+			existing: []Rect{initial, {508, 288, 1508, 888}, {556, 336, 1556, 936}},
+			want:     Rect{604, 384, 1604, 984},
+		},
+		{
+			name:     "nearly coinciding window counts as occupied",
+			window:   initial,
+			existing: []Rect{{470, 250, 1470, 850}},
+			want:     Rect{508, 288, 1508, 888},
+		},
+		{
+			name:     "custom step",
+			window:   initial,
+			existing: []Rect{initial},
+			step:     10,
+			want:     Rect{475, 255, 1475, 855},
+		},
+		{
+			name:     "wraps to top-left when overflowing",
+			window:   initial,
+			existing: []Rect{initial, {508, 288, 1508, 888}, {556, 336, 1556, 936}, {604, 384, 1604, 984}, {652, 432, 1652, 1032}},
+			want:     Rect{0, 40, 1000, 640},
+		},
+		{
+			name:   "wrapped cascade continues",
+			window: initial,
+			existing: []Rect{
+				initial, {508, 288, 1508, 888}, {556, 336, 1556, 936}, {604, 384, 1604, 984},
+				{652, 432, 1652, 1032}, {0, 40, 1000, 640}, {48, 88, 1048, 688},
+			},
+			want: Rect{96, 136, 1096, 736},
+		},
+		{
+			name:     "window is fitted to work area first",
+			window:   Rect{1500, 900, 2500, 1500},
+			existing: []Rect{},
+			want:     Rect{920, 440, 1920, 1040},
+		},
+		{
+			name:      "nil monitor cascades without bounds",
+			noMonitor: true,
+			window:    Rect{1800, 1000, 2000, 1100},
+			existing:  []Rect{{1800, 1000, 2000, 1100}},
+			want:      Rect{1832, 1032, 2032, 1132},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := monitor
+			if tt.noMonitor {
+				m = nil
+			}
+			got := CascadePlacement(m, tt.window, tt.existing, tt.step)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("every position occupied terminates", func(t *testing.T) {
+		small := &Monitor{
+			Bounds:   Rect{0, 0, 100, 100},
+			WorkArea: Rect{0, 0, 100, 100},
+			Scale:    1.0,
+		}
+		window := Rect{0, 0, 100, 100}
+		got := CascadePlacement(small, window, []Rect{window}, 0)
+		if got != window {
+			t.Errorf("got %v, want %v", got, window)
+		}
+	})
+}
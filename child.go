@@ -0,0 +1,50 @@
+package multimon
+
+import "fmt"
+
+// ChildOptions specifies how PlaceChild aligns a child window over its parent
+type ChildOptions struct {
+	// Gravity is the point of the parent rect the child is aligned to,
+	// GravityCenter centers the child over its parent
+	Gravity Gravity
+	// OffsetX and OffsetY are offsets from the gravity point in logical units,
+	// see PlaceAtGravity for their meaning
+	OffsetX, OffsetY int
+}
+
+// PlaceChild places a child window, such as a modal dialog, over its parent.
+// The child is placed on the monitor that holds most of the parent, even if
+// the parent extends beyond that monitor or is entirely off-screen:
+// 1. Child size is converted from logical units to screen units using the monitor's scale
+// 2. Child is aligned with the parent rect according to options (centered by default)
+// 3. Child is fitted to the monitor's work area
+//
+// Parameters:
+// - parent: parent window rect in screen units
+// - childSize: child window size in logical units
+// - opts: alignment options
+//
+// Returns the child rect in screen units and the monitor's scale factor.
+// Returns error if parent or child size are invalid, or if no valid monitors are available.
+func PlaceChild(monitors []Monitor, parent Rect, childSize Size, opts ChildOptions) (Rect, float64, error) {
+	if err := validateRect(parent); err != nil {
+		return Rect{}, 1.0, fmt.Errorf("invalid parent: %w", err)
+	}
+	if childSize.Width <= 0 || childSize.Height <= 0 {
+		return Rect{}, 1.0, fmt.Errorf("invalid child size: %w: width=%d, height=%d",
+			ErrInvalidDimensions, childSize.Width, childSize.Height)
+	}
+
+	m := findValidMonitor(monitors, parent)
+	if m == nil {
+		return Rect{}, 1.0, ErrNoMonitors
+	}
+
+	width := int(float64(childSize.Width) * m.Scale)
+	height := int(float64(childSize.Height) * m.Scale)
+	offsetX := int(float64(opts.OffsetX) * m.Scale)
+	offsetY := int(float64(opts.OffsetY) * m.Scale)
+
+	child := alignInRect(parent, opts.Gravity, width, height, offsetX, offsetY)
+	return FitToMonitor(m, FitModeWorkArea, child, 0.0)
+}
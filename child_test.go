@@ -0,0 +1,125 @@
+package multimon
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPlaceChild(t *testing.T) {
+	monitors := []Monitor{
+		{
+			Bounds:   Rect{0, 0, 1920, 1080},
+			WorkArea: Rect{0, 0, 1920, 1040},
+			Scale:    1.0,
+		},
+		{
+			Bounds:   Rect{1920, 0, 4800, 1620},
+			WorkArea: Rect{1920, 40, 4800, 1620},
+			Scale:    1.5,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		parent    Rect
+		size      Size
+		opts      ChildOptions
+		want      Rect
+		wantScale float64
+	}{
+		{
+			name:      "centered over parent",
+			parent:    Rect{100, 100, 1100, 900},
+			size:      Size{400, 300},
+			want:      Rect{400, 350, 800, 650},
+			wantScale: 1.0,
+		},
+		{
+			name:      "rescaled to monitor scale",
+			parent:    Rect{2000, 100, 3500, 1300},
+			size:      Size{400, 300},
+			want:      Rect{2450, 475, 3050, 925},
+			wantScale: 1.5,
+		},
+		{
+			name:      "parent hanging off the right edge",
+			parent:    Rect{1500, 100, 2200, 700},
+			size:      Size{600, 200},
+			want:      Rect{1320, 300, 1920, 500},
+			wantScale: 1.0,
+		},
+		{
+			name:      "parent mostly on second monitor",
+			parent:    Rect{1700, 100, 2900, 700},
+			size:      Size{400, 200},
+			want:      Rect{2000, 250, 2600, 550},
+			wantScale: 1.5,
+		},
+		{
+			name:      "parent entirely off-screen",
+			parent:    Rect{-900, -700, -100, -100},
+			size:      Size{400, 300},
+			want:      Rect{0, 0, 400, 300},
+			wantScale: 1.0,
+		},
+		{
+			name:      "aligned to top-left with offset",
+			parent:    Rect{100, 100, 1100, 900},
+			size:      Size{400, 300},
+			opts:      ChildOptions{Gravity: GravityNorthWest, OffsetX: 20, OffsetY: 30},
+			want:      Rect{120, 130, 520, 430},
+			wantScale: 1.0,
+		},
+		{
+			name:      "aligned to bottom-right",
+			parent:    Rect{100, 100, 1100, 900},
+			size:      Size{400, 300},
+			opts:      ChildOptions{Gravity: GravitySouthEast},
+			want:      Rect{700, 600, 1100, 900},
+			wantScale: 1.0,
+		},
+		{
+			name:      "child larger than work area",
+			parent:    Rect{100, 100, 1100, 900},
+			size:      Size{2400, 1200},
+			want:      Rect{0, 0, 1920, 1040},
+			wantScale: 1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotScale, err := PlaceChild(monitors, tt.parent, tt.size, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got rect %v, want %v", got, tt.want)
+			}
+			if gotScale != tt.wantScale {
+				t.Errorf("got scale %v, want %v", gotScale, tt.wantScale)
+			}
+		})
+	}
+
+	t.Run("invalid parent", func(t *testing.T) {
+		_, _, err := PlaceChild(monitors, Rect{100, 100, 0, 0}, Size{400, 300}, ChildOptions{})
+		if !errors.Is(err, ErrInvalidDimensions) {
+			t.Errorf("got error %v, want %v", err, ErrInvalidDimensions)
+		}
+	})
+
+	t.Run("invalid child size", func(t *testing.T) {
+		_, _, err := PlaceChild(monitors, Rect{100, 100, 900, 700}, Size{0, 300}, ChildOptions{})
+		if !errors.Is(err, ErrInvalidDimensions) {
+			t.Errorf("got error %v, want %v", err, ErrInvalidDimensions)
+		}
+	})
+
+	t.Run("no monitors", func(t *testing.T) {
+		_, _, err := PlaceChild(nil, Rect{100, 100, 900, 700}, Size{400, 300}, ChildOptions{})
+		if !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("got error %v, want %v", err, ErrNoMonitors)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package multimon
+
+import "math"
+
+// Constraints specifies size limits of a window in logical units,
+// similar to X11 WM_NORMAL_HINTS. Zero values mean no constraint.
+type Constraints struct {
+	MinWidth  int // Minimum width
+	MinHeight int // Minimum height
+	MaxWidth  int // Maximum width
+	MaxHeight int // Maximum height
+
+	// MinAspect and MaxAspect bound the width/height ratio.
+	// Set both to the same value for a fixed aspect ratio.
+	MinAspect float64
+	MaxAspect float64
+
+	// WidthIncrement and HeightIncrement restrict the size to BaseWidth and
+	// BaseHeight plus a multiple of the increment (e.g. terminal character cells)
+	WidthIncrement  int
+	HeightIncrement int
+	BaseWidth       int
+	BaseHeight      int
+}
+
+// constrainSize applies constraints to a window size in screen units.
+// The size is shrunk to fit limitWidth and limitHeight, then to the maximum
+// size, the aspect ratio and the size increments. Finally the minimum size
+// is enforced as far as the limits allow, even if this breaks the aspect
+// ratio or increments.
+func constrainSize(c Constraints, width, height int, scale float64, limitWidth, limitHeight int) (int, int) {
+	toScreen := func(v int) int { return int(float64(v) * scale) }
+
+	width = min(width, limitWidth)
+	height = min(height, limitHeight)
+	if c.MaxWidth > 0 {
+		width = min(width, toScreen(c.MaxWidth))
+	}
+	if c.MaxHeight > 0 {
+		height = min(height, toScreen(c.MaxHeight))
+	}
+
+	if c.MaxAspect > 0 && float64(width) > float64(height)*c.MaxAspect {
+		width = int(float64(height) * c.MaxAspect)
+	}
+	if c.MinAspect > 0 && float64(width) < float64(height)*c.MinAspect {
+		height = int(float64(width) / c.MinAspect)
+	}
+
+	width = snapToIncrement(width, toScreen(c.BaseWidth), float64(c.WidthIncrement)*scale)
+	height = snapToIncrement(height, toScreen(c.BaseHeight), float64(c.HeightIncrement)*scale)
+
+	width = max(width, min(toScreen(c.MinWidth), limitWidth))
+	height = max(height, min(toScreen(c.MinHeight), limitHeight))
+	return width, height
+}
+
+// snapToIncrement shrinks size to base plus a multiple of increment.
+// Sizes not exceeding base and increments up to one unit are not changed.
+func snapToIncrement(size, base int, increment float64) int {
+	if increment <= 1 || size <= base {
+		return size
+	}
+	n := math.Floor(float64(size-base) / increment)
+	return base + int(n*increment)
+}
+
+// CalcPlacementSizeConstrained is a variant of CalcPlacementSize that applies
+// window size constraints. The minimum size is taken from the constraints.
+// The desired size is fitted to the work area as in CalcPlacementSize, then
+// reduced to satisfy maximum size, aspect ratio and size increments.
+//
+// Parameters:
+// - desiredWidth, desiredHeight: preferred window size in logical units
+// - margin: minimum distance from work area edges in logical units
+// - c: size constraints in logical units
+//
+// Returns width and height in screen units.
+func CalcPlacementSizeConstrained(m *Monitor, desiredWidth, desiredHeight, margin int, c Constraints) (width, height int) {
+	width, height = CalcPlacementSize(m, desiredWidth, desiredHeight, c.MinWidth, c.MinHeight, margin)
+	if m == nil {
+		return constrainSize(c, width, height, 1.0, math.MaxInt, math.MaxInt)
+	}
+	return constrainSize(c, width, height, m.Scale,
+		m.WorkArea.Right-m.WorkArea.Left, m.WorkArea.Bottom-m.WorkArea.Top)
+}
@@ -0,0 +1,212 @@
+package multimon
+
+import "testing"
+
+func TestConstrainSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		c             Constraints
+		width, height int
+		scale         float64
+		wantW, wantH  int
+	}{
+		{
+			name:   "no constraints",
+			width:  800,
+			height: 600,
+			scale:  1.0,
+			wantW:  800,
+			wantH:  600,
+		},
+		{
+			name:   "limited to bounds",
+			width:  2500,
+			height: 600,
+			scale:  1.0,
+			wantW:  1920,
+			wantH:  600,
+		},
+		{
+			name:   "maximum size",
+			c:      Constraints{MaxWidth: 1000, MaxHeight: 500},
+			width:  1200,
+			height: 800,
+			scale:  1.0,
+			wantW:  1000,
+			wantH:  500,
+		},
+		{
+			name:   "maximum size is scaled",
+			c:      Constraints{MaxWidth: 1000, MaxHeight: 500},
+			width:  1800,
+			height: 800,
+			scale:  1.5,
+			wantW:  1500,
+			wantH:  750,
+		},
+		{
+			name:   "fixed aspect too tall",
+			c:      Constraints{MinAspect: 16.0 / 9, MaxAspect: 16.0 / 9},
+			width:  1000,
+			height: 1000,
+			scale:  1.0,
+			wantW:  1000,
+			wantH:  562,
+		},
+		{
+			name:   "fixed aspect too wide",
+			c:      Constraints{MinAspect: 4.0 / 3, MaxAspect: 4.0 / 3},
+			width:  1920,
+			height: 600,
+			scale:  1.0,
+			wantW:  800,
+			wantH:  600,
+		},
+		{
+			name:   "aspect within bounds",
+			c:      Constraints{MinAspect: 1.0, MaxAspect: 2.0},
+			width:  1200,
+			height: 800,
+			scale:  1.0,
+			wantW:  1200,
+			wantH:  800,
+		},
+		{
+			name:   "size increments",
+			c:      Constraints{WidthIncrement: 8, HeightIncrement: 16, BaseWidth: 4, BaseHeight: 4},
+			width:  803,
+			height: 605,
+			scale:  1.0,
+			wantW:  796,
+			wantH:  596,
+		},
+		{
+			name:   "size increments are scaled",
+			c:      Constraints{WidthIncrement: 8, HeightIncrement: 16},
+			width:  803,
+			height: 605,
+			scale:  1.5,
+			wantW:  792,
+			wantH:  600,
+		},
+		{
+			name:   "minimum size wins",
+			c:      Constraints{MinWidth: 1000, MaxAspect: 1.0},
+			width:  800,
+			height: 600,
+			scale:  1.0,
+			wantW:  1000,
+			wantH:  600,
+		},
+		{
+			name:   "minimum size limited to bounds",
+			c:      Constraints{MinWidth: 3000},
+			width:  800,
+			height: 600,
+			scale:  1.0,
+			wantW:  1920,
+			wantH:  600,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := constrainSize(tt.c, tt.width, tt.height, tt.scale, 1920, 1080)
+			if w != tt.wantW || h != tt.wantH {
+				t.Errorf("got %dx%d, want %dx%d", w, h, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestCalcPlacementSizeConstrained(t *testing.T) {
+	monitor := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 40, 1920, 1040},
+		Scale:    1.5,
+	}
+
+	tests := []struct {
+		name         string
+		monitor      *Monitor
+		c            Constraints
+		wantW, wantH int
+	}{
+		{
+			name:    "no constraints",
+			monitor: monitor,
+			wantW:   1500,
+			wantH:   900,
+		},
+		{
+			name:    "maximum width",
+			monitor: monitor,
+			c:       Constraints{MaxWidth: 800},
+			wantW:   1200,
+			wantH:   900,
+		},
+		{
+			name:    "fixed aspect",
+			monitor: monitor,
+			c:       Constraints{MinAspect: 2.0, MaxAspect: 2.0},
+			wantW:   1500,
+			wantH:   750,
+		},
+		{
+			name:    "minimum size",
+			monitor: monitor,
+			c:       Constraints{MinWidth: 1200, MinHeight: 800},
+			wantW:   1800,
+			wantH:   1000,
+		},
+		{
+			name:    "nil monitor",
+			monitor: nil,
+			c:       Constraints{MaxWidth: 800},
+			wantW:   800,
+			wantH:   600,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := CalcPlacementSizeConstrained(tt.monitor, 1000, 600, 0, tt.c)
+			if w != tt.wantW || h != tt.wantH {
+				t.Errorf("got %dx%d, want %dx%d", w, h, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestFitWithConstraints(t *testing.T) {
+	t.Run("FitToMonitorWithOptions", func(t *testing.T) {
+		monitor := &Monitor{
+			Bounds:   Rect{0, 0, 1920, 1080},
+			WorkArea: Rect{0, 0, 1920, 1080},
+			Scale:    1.0,
+		}
+		opts := FitOptions{Constraints: Constraints{MaxWidth: 800, WidthIncrement: 10, BaseWidth: 5}}
+		got, _, err := FitToMonitorWithOptions(monitor, FitModeBounds, Rect{100, 100, 1100, 700}, 0, opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := (Rect{100, 100, 895, 700}); got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("FitToNearestMonitorWithOptions", func(t *testing.T) {
+		monitors := []Monitor{
+			{Bounds: Rect{0, 0, 1280, 720}, WorkArea: Rect{0, 0, 1280, 720}, Scale: 1.0},
+			{Bounds: Rect{1280, 0, 3200, 1080}, WorkArea: Rect{1280, 0, 3200, 1080}, Scale: 1.0},
+		}
+		opts := FitOptions{Constraints: Constraints{MinWidth: 1500, MinHeight: 300}}
+		got, _, err := FitToNearestMonitorWithOptions(monitors, FitModeBounds, Rect{100, 100, 500, 400}, 0, 0, 0, opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := (Rect{1280, 100, 2780, 400}); got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
@@ -1,11 +1,22 @@
 package multimon
 
+import "math"
+
 // Point represents a point in 2D space
 type Point struct {
 	X, Y int
 }
 
+// Size represents the dimensions of a rectangle
+type Size struct {
+	Width, Height int
+}
+
 // LogicalToScreenRect converts logical coordinates to screen units for a given monitor
+//
+// Deprecated: absolute coordinates are scaled around the desktop origin, so the
+// result may land on a different monitor. Use MonitorLogicalToScreenRect or
+// GlobalLogicalToScreenRect instead.
 func LogicalToScreenRect(m Monitor, logical Rect) Rect {
 	// Convert from logical units to screen units by multiplying by scale factor
 	return Rect{
@@ -17,6 +28,10 @@ func LogicalToScreenRect(m Monitor, logical Rect) Rect {
 }
 
 // ScreenToLogicalRect converts screen coordinates to logical units for a given monitor
+//
+// Deprecated: absolute coordinates are scaled around the desktop origin, so the
+// result may land on a different monitor. Use ScreenToMonitorLogicalRect or
+// ScreenToGlobalLogicalRect instead.
 func ScreenToLogicalRect(m Monitor, screen Rect) Rect {
 	// Convert from screen units to logical units by dividing by scale factor
 	return Rect{
@@ -28,6 +43,8 @@ func ScreenToLogicalRect(m Monitor, screen Rect) Rect {
 }
 
 // LogicalToScreenPoint converts a logical point to screen coordinates for a given monitor
+//
+// Deprecated: use MonitorLogicalToScreenPoint or GlobalLogicalToScreenPoint instead.
 func LogicalToScreenPoint(m Monitor, x, y int) Point {
 	return Point{
 		X: int(float64(x) * m.Scale),
@@ -36,9 +53,101 @@ func LogicalToScreenPoint(m Monitor, x, y int) Point {
 }
 
 // ScreenToLogicalPoint converts a screen point to logical coordinates for a given monitor
+//
+// Deprecated: use ScreenToMonitorLogicalPoint or ScreenToGlobalLogicalPoint instead.
 func ScreenToLogicalPoint(m Monitor, x, y int) Point {
 	return Point{
 		X: int(float64(x) / m.Scale),
 		Y: int(float64(y) / m.Scale),
 	}
 }
+
+// ScreenToMonitorLogicalRect converts a rect in screen coordinates to logical
+// coordinates relative to the top-left corner of monitor m's bounds.
+// Coordinates are rounded down, so points left of or above the monitor
+// get negative coordinates. Use ScreenToMonitorLogicalRectF to choose the rounding.
+func ScreenToMonitorLogicalRect(m Monitor, screen Rect) Rect {
+	return Rect{
+		Left:   int(math.Floor(float64(screen.Left-m.Bounds.Left) / m.Scale)),
+		Top:    int(math.Floor(float64(screen.Top-m.Bounds.Top) / m.Scale)),
+		Right:  int(math.Floor(float64(screen.Right-m.Bounds.Left) / m.Scale)),
+		Bottom: int(math.Floor(float64(screen.Bottom-m.Bounds.Top) / m.Scale)),
+	}
+}
+
+// MonitorLogicalToScreenRect converts a rect in logical coordinates relative
+// to the top-left corner of monitor m's bounds to screen coordinates
+func MonitorLogicalToScreenRect(m Monitor, logical Rect) Rect {
+	return Rect{
+		Left:   m.Bounds.Left + int(math.Floor(float64(logical.Left)*m.Scale)),
+		Top:    m.Bounds.Top + int(math.Floor(float64(logical.Top)*m.Scale)),
+		Right:  m.Bounds.Left + int(math.Floor(float64(logical.Right)*m.Scale)),
+		Bottom: m.Bounds.Top + int(math.Floor(float64(logical.Bottom)*m.Scale)),
+	}
+}
+
+// ScreenToMonitorLogicalPoint converts a screen point to logical coordinates
+// relative to the top-left corner of monitor m's bounds
+func ScreenToMonitorLogicalPoint(m Monitor, x, y int) Point {
+	return Point{
+		X: int(math.Floor(float64(x-m.Bounds.Left) / m.Scale)),
+		Y: int(math.Floor(float64(y-m.Bounds.Top) / m.Scale)),
+	}
+}
+
+// MonitorLogicalToScreenPoint converts a point in logical coordinates relative
+// to the top-left corner of monitor m's bounds to screen coordinates
+func MonitorLogicalToScreenPoint(m Monitor, x, y int) Point {
+	return Point{
+		X: m.Bounds.Left + int(math.Floor(float64(x)*m.Scale)),
+		Y: m.Bounds.Top + int(math.Floor(float64(y)*m.Scale)),
+	}
+}
+
+// ScreenToGlobalLogicalRect converts a rect in screen coordinates to the global
+// logical desktop built by NewLogicalLayout, using the monitor that holds most of it.
+// To convert many rects, build the layout once and use its methods instead.
+// Returns ErrNoMonitors if no valid monitors are available.
+func ScreenToGlobalLogicalRect(monitors []Monitor, screen Rect) (Rect, error) {
+	layout, err := NewLogicalLayout(monitors)
+	if err != nil {
+		return screen, err
+	}
+	return layout.ScreenToGlobalLogicalRect(screen)
+}
+
+// GlobalLogicalToScreenRect converts a rect in the global logical desktop built
+// by NewLogicalLayout to screen coordinates, using the monitor whose logical
+// rect holds most of it.
+// Returns ErrNoMonitors if no valid monitors are available.
+func GlobalLogicalToScreenRect(monitors []Monitor, logical Rect) (Rect, error) {
+	layout, err := NewLogicalLayout(monitors)
+	if err != nil {
+		return logical, err
+	}
+	return layout.GlobalLogicalToScreenRect(logical)
+}
+
+// ScreenToGlobalLogicalPoint converts a screen point to the global logical desktop.
+// Returns ErrNoMonitors if no valid monitors are available.
+func ScreenToGlobalLogicalPoint(monitors []Monitor, x, y int) (Point, error) {
+	r, err := ScreenToGlobalLogicalRect(monitors, Rect{Left: x, Top: y, Right: x + 1, Bottom: y + 1})
+	return Point{X: r.Left, Y: r.Top}, err
+}
+
+// GlobalLogicalToScreenPoint converts a point in the global logical desktop
+// to screen coordinates. Returns ErrNoMonitors if no valid monitors are available.
+func GlobalLogicalToScreenPoint(monitors []Monitor, x, y int) (Point, error) {
+	r, err := GlobalLogicalToScreenRect(monitors, Rect{Left: x, Top: y, Right: x + 1, Bottom: y + 1})
+	return Point{X: r.Left, Y: r.Top}, err
+}
+
+// offsetRect moves a rect by dx, dy
+func offsetRect(r Rect, dx, dy int) Rect {
+	return Rect{
+		Left:   r.Left + dx,
+		Top:    r.Top + dy,
+		Right:  r.Right + dx,
+		Bottom: r.Bottom + dy,
+	}
+}
@@ -1,6 +1,9 @@
 package multimon
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestCoordinateConversion(t *testing.T) {
 	// Test monitor with 200% scaling
@@ -168,3 +171,156 @@ func TestRoundTrip(t *testing.T) {
 		}
 	}
 }
+
+func TestMonitorLogicalConversion(t *testing.T) {
+	// 200% monitor to the right of a 100% monitor
+	m := Monitor{
+		Bounds:   Rect{1920, 0, 5760, 2160},
+		WorkArea: Rect{1920, 0, 5760, 2160},
+		Scale:    2.0,
+	}
+
+	tests := []struct {
+		name    string
+		logical Rect
+		screen  Rect
+	}{
+		{
+			name:    "origin",
+			logical: Rect{0, 0, 100, 100},
+			screen:  Rect{1920, 0, 2120, 200},
+		},
+		{
+			name:    "center",
+			logical: Rect{960, 540, 1060, 640},
+			screen:  Rect{3840, 1080, 4040, 1280},
+		},
+		{
+			name:    "left of monitor",
+			logical: Rect{-100, 0, 0, 100},
+			screen:  Rect{1720, 0, 1920, 200},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MonitorLogicalToScreenRect(m, tt.logical); got != tt.screen {
+				t.Errorf("MonitorLogicalToScreenRect() = %+v, want %+v", got, tt.screen)
+			}
+			if got := ScreenToMonitorLogicalRect(m, tt.screen); got != tt.logical {
+				t.Errorf("ScreenToMonitorLogicalRect() = %+v, want %+v", got, tt.logical)
+			}
+			wantPoint := Point{tt.logical.Left, tt.logical.Top}
+			if got := ScreenToMonitorLogicalPoint(m, tt.screen.Left, tt.screen.Top); got != wantPoint {
+				t.Errorf("ScreenToMonitorLogicalPoint() = %+v, want %+v", got, wantPoint)
+			}
+			wantPoint = Point{tt.screen.Left, tt.screen.Top}
+			if got := MonitorLogicalToScreenPoint(m, tt.logical.Left, tt.logical.Top); got != wantPoint {
+				t.Errorf("MonitorLogicalToScreenPoint() = %+v, want %+v", got, wantPoint)
+			}
+		})
+	}
+
+	t.Run("negative offsets round down", func(t *testing.T) {
+		if got := ScreenToMonitorLogicalPoint(m, 1919, -1); got != (Point{-1, -1}) {
+			t.Errorf("ScreenToMonitorLogicalPoint() = %+v, want %+v", got, Point{-1, -1})
+		}
+		want := Rect{-1, -1, 0, 0}
+		if got := ScreenToMonitorLogicalRect(m, Rect{1919, -1, 1920, 0}); got != want {
+			t.Errorf("ScreenToMonitorLogicalRect() = %+v, want %+v", got, want)
+		}
+	})
+}
+
+func TestGlobalLogicalConversion(t *testing.T) {
+	monitors := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 5760, 2160}, WorkArea: Rect{1920, 0, 5760, 2160}, Scale: 2.0},
+	}
+
+	tests := []struct {
+		name    string
+		logical Rect
+		screen  Rect
+	}{
+		{
+			name:    "100% monitor",
+			logical: Rect{100, 100, 300, 300},
+			screen:  Rect{100, 100, 300, 300},
+		},
+		{
+			name:    "200% monitor stays on it",
+			logical: Rect{2880, 540, 2980, 640},
+			screen:  Rect{3840, 1080, 4040, 1280},
+		},
+		{
+			name:    "200% monitor origin",
+			logical: Rect{1920, 0, 2020, 100},
+			screen:  Rect{1920, 0, 2120, 200},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ScreenToGlobalLogicalRect(monitors, tt.screen)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.logical {
+				t.Errorf("ScreenToGlobalLogicalRect() = %+v, want %+v", got, tt.logical)
+			}
+
+			got, err = GlobalLogicalToScreenRect(monitors, tt.logical)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.screen {
+				t.Errorf("GlobalLogicalToScreenRect() = %+v, want %+v", got, tt.screen)
+			}
+		})
+	}
+
+	t.Run("points", func(t *testing.T) {
+		p, err := ScreenToGlobalLogicalPoint(monitors, 3000, 500)
+		if err != nil || p != (Point{2460, 250}) {
+			t.Errorf("ScreenToGlobalLogicalPoint() = %+v, %v, want %+v", p, err, Point{2460, 250})
+		}
+		p, err = GlobalLogicalToScreenPoint(monitors, 2460, 250)
+		if err != nil || p != (Point{3000, 500}) {
+			t.Errorf("GlobalLogicalToScreenPoint() = %+v, %v, want %+v", p, err, Point{3000, 500})
+		}
+	})
+
+	t.Run("matches LogicalLayout", func(t *testing.T) {
+		left := []Monitor{
+			{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+			{Bounds: Rect{-3840, 0, 0, 2160}, WorkArea: Rect{-3840, 0, 0, 2160}, Scale: 2.0},
+		}
+		layout, err := NewLogicalLayout(left)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		screen := Rect{-200, 0, -100, 100}
+		want, _ := layout.ScreenToGlobalLogicalRect(screen)
+		if want != (Rect{-100, 0, -50, 50}) {
+			t.Fatalf("LogicalLayout.ScreenToGlobalLogicalRect() = %+v, want %+v", want, Rect{-100, 0, -50, 50})
+		}
+		got, err := ScreenToGlobalLogicalRect(left, screen)
+		if err != nil || got != want {
+			t.Errorf("ScreenToGlobalLogicalRect() = %+v, %v, want %+v", got, err, want)
+		}
+		back, err := GlobalLogicalToScreenRect(left, got)
+		if err != nil || back != screen {
+			t.Errorf("GlobalLogicalToScreenRect() = %+v, %v, want %+v", back, err, screen)
+		}
+	})
+
+	t.Run("no monitors", func(t *testing.T) {
+		if _, err := ScreenToGlobalLogicalRect(nil, Rect{0, 0, 100, 100}); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("ScreenToGlobalLogicalRect() error = %v, want %v", err, ErrNoMonitors)
+		}
+		if _, err := GlobalLogicalToScreenRect(nil, Rect{0, 0, 100, 100}); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("GlobalLogicalToScreenRect() error = %v, want %v", err, ErrNoMonitors)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package multimon
+
+import "fmt"
+
+// DPITransition is the suggested result of moving a window to a monitor
+// with a different scale factor
+type DPITransition struct {
+	Rect    Rect     // Suggested window rect in screen units
+	Scale   float64  // Scale factor of the new monitor
+	Monitor *Monitor // New monitor
+	// Oscillates is true if most of the resized window would lie on another
+	// monitor with a different scale factor, so that applying the change would
+	// immediately trigger a change back. Callers should then keep the current
+	// rect and scale, for example until the drag ends.
+	Oscillates bool
+}
+
+// PlanDPIChange calculates the new window rect when a window moves to
+// a monitor with a different scale factor, as Windows does for WM_DPICHANGED.
+// The window is resized by the ratio of the scale factors while the point
+// under the cursor stays fixed, so the window does not jump away from the pointer.
+//
+// Parameters:
+// - monitors: available monitors, used for oscillation detection
+// - window: current window rect in screen units
+// - oldScale: scale factor the window is currently rendered at
+// - m: new monitor the window is moving to
+// - cursor: cursor position in screen units
+//
+// Returns error if window has invalid dimensions, oldScale is not positive or m is invalid.
+func PlanDPIChange(monitors []Monitor, window Rect, oldScale float64, m *Monitor, cursor Point) (DPITransition, error) {
+	if err := validateRect(window); err != nil {
+		return DPITransition{}, fmt.Errorf("invalid window: %w", err)
+	}
+	if oldScale <= 0.0 {
+		return DPITransition{}, fmt.Errorf("invalid scale: %v (must be positive non-zero)", oldScale)
+	}
+	if m == nil {
+		return DPITransition{}, ErrNoMonitors
+	}
+	if err := validateMonitor(*m); err != nil {
+		return DPITransition{}, err
+	}
+
+	t := DPITransition{
+		Rect:    window,
+		Scale:   m.Scale,
+		Monitor: m,
+	}
+	if m.Scale != oldScale {
+		t.Rect = scaleAroundAnchor(window, m.Scale/oldScale, FitOptions{
+			Anchor:      ScaleAnchorPoint,
+			AnchorPoint: cursor,
+		})
+	}
+
+	if holder := findValidMonitor(monitors, t.Rect); holder != nil {
+		t.Oscillates = holder.Bounds != m.Bounds && holder.Scale != m.Scale
+	}
+	return t, nil
+}
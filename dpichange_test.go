@@ -0,0 +1,102 @@
+package multimon
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPlanDPIChange(t *testing.T) {
+	monitors := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 4800, 1620}, WorkArea: Rect{1920, 0, 4800, 1620}, Scale: 1.5},
+	}
+
+	tests := []struct {
+		name           string
+		window         Rect
+		oldScale       float64
+		monitor        int
+		cursor         Point
+		want           Rect
+		wantOscillates bool
+	}{
+		{
+			name:           "100% to 150%, resized window falls back",
+			window:         Rect{1500, 100, 2300, 700},
+			oldScale:       1.0,
+			monitor:        1,
+			cursor:         Point{2000, 120},
+			want:           Rect{1250, 90, 2450, 990},
+			wantOscillates: true,
+		},
+		{
+			name:           "100% to 150%",
+			window:         Rect{1800, 100, 2600, 700},
+			oldScale:       1.0,
+			monitor:        1,
+			cursor:         Point{2500, 120},
+			want:           Rect{1450, 90, 2650, 990},
+			wantOscillates: false,
+		},
+		{
+			name:           "150% to 100%, resized window falls back",
+			window:         Rect{1900, 100, 3100, 1000},
+			oldScale:       1.5,
+			monitor:        0,
+			cursor:         Point{1910, 110},
+			want:           Rect{1903, 103, 2703, 703},
+			wantOscillates: true,
+		},
+		{
+			name:           "150% to 100%",
+			window:         Rect{1000, 100, 2200, 1000},
+			oldScale:       1.5,
+			monitor:        0,
+			cursor:         Point{1600, 130},
+			want:           Rect{1200, 110, 2000, 710},
+			wantOscillates: false,
+		},
+		{
+			name:           "same scale",
+			window:         Rect{1800, 100, 2600, 700},
+			oldScale:       1.5,
+			monitor:        1,
+			cursor:         Point{2500, 120},
+			want:           Rect{1800, 100, 2600, 700},
+			wantOscillates: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PlanDPIChange(monitors, tt.window, tt.oldScale, &monitors[tt.monitor], tt.cursor)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Rect != tt.want {
+				t.Errorf("got %v, want %v", got.Rect, tt.want)
+			}
+			if got.Scale != monitors[tt.monitor].Scale {
+				t.Errorf("got scale %v, want %v", got.Scale, monitors[tt.monitor].Scale)
+			}
+			if got.Monitor != &monitors[tt.monitor] {
+				t.Errorf("got monitor %v, want %v", got.Monitor, monitors[tt.monitor])
+			}
+			if got.Oscillates != tt.wantOscillates {
+				t.Errorf("got oscillates %v, want %v", got.Oscillates, tt.wantOscillates)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := PlanDPIChange(monitors, Rect{0, 0, 0, 100}, 1.0, &monitors[1], Point{}); !errors.Is(err, ErrInvalidDimensions) {
+			t.Errorf("invalid window: got error %v, want %v", err, ErrInvalidDimensions)
+		}
+		if _, err := PlanDPIChange(monitors, Rect{0, 0, 100, 100}, 0, &monitors[1], Point{}); err == nil {
+			t.Errorf("invalid scale: expected error, got nil")
+		}
+		if _, err := PlanDPIChange(monitors, Rect{0, 0, 100, 100}, 1.0, nil, Point{}); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("nil monitor: got error %v, want %v", err, ErrNoMonitors)
+		}
+	})
+}
@@ -116,3 +116,30 @@ func GetWorkAreaForRect(monitors []Monitor, rect Rect) Rect {
 	}
 	return m.WorkArea
 }
+
+// findValidMonitor finds the valid monitor with the largest overlap with the given rect,
+// or the valid monitor with the smallest edge distance if nothing overlaps.
+// Monitors that fail validation are skipped.
+// Returns nil if no valid monitors are available.
+func findValidMonitor(monitors []Monitor, rect Rect) *Monitor {
+	var best *Monitor
+	maxArea := 0
+	minDist := math.MaxInt
+
+	for i := range monitors {
+		m := &monitors[i]
+		if validateMonitor(*m) != nil {
+			continue
+		}
+		if area := getOverlapArea(rect, m.Bounds); area > maxArea {
+			maxArea = area
+			best = m
+		} else if maxArea == 0 {
+			if dist := getEdgeDistance(rect, m.Bounds); best == nil || dist < minDist {
+				minDist = dist
+				best = m
+			}
+		}
+	}
+	return best
+}
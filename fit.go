@@ -18,10 +18,37 @@ type FitMode int
 const (
 	// FitModeBounds fits to monitor's total bounds
 	FitModeBounds FitMode = iota
-	// FitModeWorkArea fits to monitor's work area (excluding taskbar, dock, etc.)
+	// FitModeWorkArea fits to monitor's work area (excluding taskbar, dock, etc.),
+	// or to one of its UsableAreas if the monitor has them
 	FitModeWorkArea
 )
 
+// ScaleAnchor specifies the point a window is rescaled around when
+// its scale differs from the monitor's scale
+type ScaleAnchor int
+
+const (
+	// ScaleAnchorTopLeft keeps the top-left corner of the window in place
+	ScaleAnchorTopLeft ScaleAnchor = iota
+	// ScaleAnchorCenter keeps the center of the window in place
+	ScaleAnchorCenter
+	// ScaleAnchorPoint keeps FitOptions.AnchorPoint (e.g. the pointer position)
+	// at the same relative position within the window
+	ScaleAnchorPoint
+)
+
+// FitOptions specifies optional behavior of FitToMonitorWithOptions and
+// FitToNearestMonitorWithOptions. The zero value matches FitToMonitor.
+type FitOptions struct {
+	// Anchor is the point the window is rescaled around
+	Anchor ScaleAnchor
+	// AnchorPoint is used with ScaleAnchorPoint, in screen units
+	AnchorPoint Point
+	// Constraints limit the fitted window size, converted to screen units
+	// with the monitor's scale
+	Constraints Constraints
+}
+
 // FitToMonitor fits a window to a specific monitor.
 // Input window coordinates are in screen units.
 // windowScale specifies what scale factor the window was designed for:
@@ -30,7 +57,18 @@ const (
 // Returns error if window or monitor has negative dimensions.
 // Returns the fitted rect and the monitor's scale factor.
 // If monitor is nil, returns windowScale if non-zero, otherwise 1.0.
+// The window is rescaled around its top-left corner, see FitToMonitorWithOptions.
 func FitToMonitor(m *Monitor, mode FitMode, window Rect, windowScale float64) (Rect, float64, error) {
+	return FitToMonitorWithOptions(m, mode, window, windowScale, FitOptions{})
+}
+
+// FitToMonitorWithOptions is a variant of FitToMonitor that rescales the window
+// around the anchor specified in opts before fitting it to the monitor.
+// With FitModeWorkArea, the window is fitted to the usable area of the monitor
+// that best accommodates it if the monitor has UsableAreas.
+// The window size is then constrained with opts.Constraints, keeping the
+// top-left corner in place.
+func FitToMonitorWithOptions(m *Monitor, mode FitMode, window Rect, windowScale float64, opts FitOptions) (Rect, float64, error) {
 	// Validate input dimensions
 	if err := validateRect(window); err != nil {
 		return window, windowScale, fmt.Errorf("invalid window: %w", err)
@@ -47,28 +85,26 @@ func FitToMonitor(m *Monitor, mode FitMode, window Rect, windowScale float64) (R
 		return window, windowScale, err
 	}
 
-	// Get target bounds based on mode
-	bounds := m.Bounds
-	if mode == FitModeWorkArea {
-		bounds = m.WorkArea
-	}
-
 	// Scale window if needed
 	var targetWindow Rect
 	if windowScale == 0.0 {
 		targetWindow = window
 	} else {
-		// Scale window dimensions relative to top-left corner
-		scaledWidth := int(float64(window.Right-window.Left) * (m.Scale / windowScale))
-		scaledHeight := int(float64(window.Bottom-window.Top) * (m.Scale / windowScale))
-		targetWindow = Rect{
-			Left:   window.Left,
-			Top:    window.Top,
-			Right:  window.Left + scaledWidth,
-			Bottom: window.Top + scaledHeight,
-		}
+		targetWindow = scaleAroundAnchor(window, m.Scale/windowScale, opts)
+	}
+
+	// Get target bounds based on mode
+	bounds := m.Bounds
+	if mode == FitModeWorkArea {
+		bounds = selectUsableArea(m, targetWindow)
 	}
 
+	width, height := constrainSize(opts.Constraints,
+		targetWindow.Right-targetWindow.Left, targetWindow.Bottom-targetWindow.Top,
+		m.Scale, bounds.Right-bounds.Left, bounds.Bottom-bounds.Top)
+	targetWindow.Right = targetWindow.Left + width
+	targetWindow.Bottom = targetWindow.Top + height
+
 	// Fit dimensions and positions within bounds
 	newLeft, newWidth := fitRectDimension(targetWindow.Left, targetWindow.Right-targetWindow.Left, bounds.Left, bounds.Right)
 	newTop, newHeight := fitRectDimension(targetWindow.Top, targetWindow.Bottom-targetWindow.Top, bounds.Top, bounds.Bottom)
@@ -81,6 +117,30 @@ func FitToMonitor(m *Monitor, mode FitMode, window Rect, windowScale float64) (R
 	}, m.Scale, nil
 }
 
+// scaleAroundAnchor scales window dimensions by factor, keeping the anchor
+// point at the same relative position within the window
+func scaleAroundAnchor(window Rect, factor float64, opts FitOptions) Rect {
+	anchorX, anchorY := window.Left, window.Top
+	switch opts.Anchor {
+	case ScaleAnchorCenter:
+		anchorX = window.Left + (window.Right-window.Left)/2
+		anchorY = window.Top + (window.Bottom-window.Top)/2
+	case ScaleAnchorPoint:
+		anchorX, anchorY = opts.AnchorPoint.X, opts.AnchorPoint.Y
+	}
+
+	scaledWidth := int(float64(window.Right-window.Left) * factor)
+	scaledHeight := int(float64(window.Bottom-window.Top) * factor)
+	left := anchorX - int(math.Round(float64(anchorX-window.Left)*factor))
+	top := anchorY - int(math.Round(float64(anchorY-window.Top)*factor))
+	return Rect{
+		Left:   left,
+		Top:    top,
+		Right:  left + scaledWidth,
+		Bottom: top + scaledHeight,
+	}
+}
+
 // validateRect checks if a rectangle has valid dimensions
 func validateRect(r Rect) error {
 	width := r.Right - r.Left
@@ -119,7 +179,18 @@ func validateMonitor(m Monitor) error {
 // Returns error if window has negative dimensions, if no valid monitors are available,
 // or if no monitor can fit the minimum size requirements.
 // If no monitors are available, returns windowScale if non-zero, otherwise 1.0.
+// The window is rescaled around its top-left corner, see FitToNearestMonitorWithOptions.
 func FitToNearestMonitor(monitors []Monitor, mode FitMode, window Rect, windowScale float64, minWidth, minHeight int) (Rect, float64, error) {
+	return FitToNearestMonitorWithOptions(monitors, mode, window, windowScale, minWidth, minHeight, FitOptions{})
+}
+
+// FitToNearestMonitorWithOptions is a variant of FitToNearestMonitor that
+// rescales the window around the anchor specified in opts, see FitToMonitorWithOptions.
+// The minimum size of opts.Constraints is also taken into account when selecting the monitor.
+func FitToNearestMonitorWithOptions(monitors []Monitor, mode FitMode, window Rect, windowScale float64, minWidth, minHeight int, opts FitOptions) (Rect, float64, error) {
+	minWidth = max(minWidth, opts.Constraints.MinWidth)
+	minHeight = max(minHeight, opts.Constraints.MinHeight)
+
 	// Validate window dimensions
 	if err := validateRect(window); err != nil {
 		return window, windowScale, fmt.Errorf("invalid window: %w", err)
@@ -157,11 +228,6 @@ func FitToNearestMonitor(monitors []Monitor, mode FitMode, window Rect, windowSc
 		suitableMonitors = validMonitors
 	} else {
 		for _, m := range validMonitors {
-			bounds := m.Bounds
-			if mode == FitModeWorkArea {
-				bounds = m.WorkArea
-			}
-
 			factor := 1.0
 			if windowScale > 0.0 {
 				factor = m.Scale / windowScale
@@ -170,10 +236,14 @@ func FitToNearestMonitor(monitors []Monitor, mode FitMode, window Rect, windowSc
 			// Check if monitor can fit minimum dimensions
 			screenMinWidth := int(float64(minWidth) * factor)
 			screenMinHeight := int(float64(minHeight) * factor)
-			width := bounds.Right - bounds.Left
-			height := bounds.Bottom - bounds.Top
+			var fits bool
+			if mode == FitModeWorkArea {
+				fits = usableAreaFits(&m, screenMinWidth, screenMinHeight)
+			} else {
+				fits = m.Bounds.Right-m.Bounds.Left >= screenMinWidth && m.Bounds.Bottom-m.Bounds.Top >= screenMinHeight
+			}
 
-			if width >= screenMinWidth && height >= screenMinHeight {
+			if fits {
 				suitableMonitors = append(suitableMonitors, m)
 			}
 		}
@@ -226,5 +296,5 @@ func FitToNearestMonitor(monitors []Monitor, mode FitMode, window Rect, windowSc
 		bestMonitor = &suitableMonitors[0]
 	}
 
-	return FitToMonitor(bestMonitor, mode, window, windowScale)
+	return FitToMonitorWithOptions(bestMonitor, mode, window, windowScale, opts)
 }
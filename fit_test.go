@@ -560,3 +560,93 @@ func TestFitToNearestMonitor(t *testing.T) {
 		})
 	}
 }
+
+func TestFitToMonitorWithOptions(t *testing.T) {
+	monitor := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 0, 1920, 1080},
+		Scale:    1.0,
+	}
+
+	tests := []struct {
+		name   string
+		window Rect
+		scale  float64
+		opts   FitOptions
+		want   Rect
+	}{
+		{
+			name:   "top-left anchor",
+			window: Rect{400, 200, 800, 600},
+			scale:  2.0,
+			opts:   FitOptions{Anchor: ScaleAnchorTopLeft},
+			want:   Rect{400, 200, 600, 400},
+		},
+		{
+			name:   "center anchor",
+			window: Rect{400, 200, 800, 600},
+			scale:  2.0,
+			opts:   FitOptions{Anchor: ScaleAnchorCenter},
+			want:   Rect{500, 300, 700, 500},
+		},
+		{
+			name:   "point anchor",
+			window: Rect{400, 200, 800, 600},
+			scale:  2.0,
+			opts:   FitOptions{Anchor: ScaleAnchorPoint, AnchorPoint: Point{700, 250}},
+			want:   Rect{550, 225, 750, 425},
+		},
+		{
+			name:   "center anchor scaling up",
+			window: Rect{400, 200, 800, 600},
+			scale:  0.5,
+			opts:   FitOptions{Anchor: ScaleAnchorCenter},
+			want:   Rect{200, 0, 1000, 800},
+		},
+		{
+			name:   "center anchor near edge is fitted",
+			window: Rect{1500, 700, 1900, 1000},
+			scale:  0.5,
+			opts:   FitOptions{Anchor: ScaleAnchorCenter},
+			want:   Rect{1120, 480, 1920, 1080},
+		},
+		{
+			name:   "anchor ignored without rescaling",
+			window: Rect{400, 200, 800, 600},
+			scale:  0.0,
+			opts:   FitOptions{Anchor: ScaleAnchorCenter},
+			want:   Rect{400, 200, 800, 600},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := FitToMonitorWithOptions(monitor, FitModeBounds, tt.window, tt.scale, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFitToNearestMonitorWithOptions(t *testing.T) {
+	monitors := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1080}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 2.0},
+	}
+
+	got, scale, err := FitToNearestMonitorWithOptions(monitors, FitModeBounds,
+		Rect{2000, 100, 2400, 500}, 1.0, 0, 0, FitOptions{Anchor: ScaleAnchorCenter})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (Rect{1920, 0, 2720, 800}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if scale != 2.0 {
+		t.Errorf("got scale %v, want %v", scale, 2.0)
+	}
+}
@@ -0,0 +1,154 @@
+package multimon
+
+// PlaceInFreeSpace positions a window in a monitor's work area where it
+// covers the least of the existing windows.
+//
+// Parameters:
+// - width, height: window size in screen units (e.g. from CalcPlacementSize)
+// - windows: rects of existing top-level windows in screen units
+//
+// If the window fits into an empty area of the work area (found with
+// a maximal empty rectangle search), it is placed there, as close to the
+// center of the work area as possible. Otherwise it is placed at the position
+// with the least total overlap with existing windows, trying the work area
+// edges, the edges of existing windows and the center of the work area.
+// Ties are resolved in favor of the position closest to the center.
+// The window size is clamped to the work area.
+// If m is nil, the window is placed at the origin.
+//
+// Returns a Rect with the window position and size in screen units.
+func PlaceInFreeSpace(m *Monitor, width, height int, windows []Rect) Rect {
+	if m == nil {
+		return Rect{Right: width, Bottom: height}
+	}
+
+	wa := m.WorkArea
+	width = max(0, min(width, wa.Right-wa.Left))
+	height = max(0, min(height, wa.Bottom-wa.Top))
+	centered := centerRect(Rect{Right: width, Bottom: height}, wa)
+
+	var best Rect
+	bestDistance := -1
+	for _, free := range maximalEmptyRects(wa, windows) {
+		if free.Right-free.Left < width || free.Bottom-free.Top < height {
+			continue
+		}
+		left, _ := fitRectDimension(centered.Left, width, free.Left, free.Right)
+		top, _ := fitRectDimension(centered.Top, height, free.Top, free.Bottom)
+		r := Rect{Left: left, Top: top, Right: left + width, Bottom: top + height}
+		if d := centerDistance(r, centered); bestDistance < 0 || d < bestDistance {
+			best, bestDistance = r, d
+		}
+	}
+	if bestDistance >= 0 {
+		return best
+	}
+
+	return leastOverlapPlacement(wa, centered, windows)
+}
+
+// leastOverlapPlacement finds the position of rect r within area that has
+// the least total overlap with windows, preferring positions close to r
+func leastOverlapPlacement(area, r Rect, windows []Rect) Rect {
+	width := r.Right - r.Left
+	height := r.Bottom - r.Top
+
+	xs := []int{r.Left, area.Left, area.Right - width}
+	ys := []int{r.Top, area.Top, area.Bottom - height}
+	for _, w := range windows {
+		xs = append(xs, w.Left-width, w.Right)
+		ys = append(ys, w.Top-height, w.Bottom)
+	}
+
+	best := r
+	bestOverlap, bestDistance := -1, 0
+	for _, x := range xs {
+		for _, y := range ys {
+			left, _ := fitRectDimension(x, width, area.Left, area.Right)
+			top, _ := fitRectDimension(y, height, area.Top, area.Bottom)
+			candidate := Rect{Left: left, Top: top, Right: left + width, Bottom: top + height}
+
+			overlap := 0
+			for _, w := range windows {
+				overlap += getOverlapArea(candidate, w)
+			}
+			distance := centerDistance(candidate, r)
+			if bestOverlap < 0 || overlap < bestOverlap || (overlap == bestOverlap && distance < bestDistance) {
+				best, bestOverlap, bestDistance = candidate, overlap, distance
+			}
+		}
+	}
+	return best
+}
+
+// centerDistance calculates the Manhattan distance between the centers of two rectangles
+func centerDistance(a, b Rect) int {
+	return abs((a.Left+a.Right)-(b.Left+b.Right))/2 + abs((a.Top+a.Bottom)-(b.Top+b.Bottom))/2
+}
+
+// maximalEmptyRects finds the maximal rectangles within area that do not
+// intersect any of the obstacles. The rectangles may overlap each other;
+// none of them is contained in another. Returns nil if area is fully covered.
+func maximalEmptyRects(area Rect, obstacles []Rect) []Rect {
+	if area.Right <= area.Left || area.Bottom <= area.Top {
+		return nil
+	}
+
+	free := []Rect{area}
+	for _, o := range obstacles {
+		if getOverlapArea(area, o) == 0 {
+			continue
+		}
+
+		var next []Rect
+		for _, f := range free {
+			if getOverlapArea(f, o) == 0 {
+				next = append(next, f)
+				continue
+			}
+			// Split f into the parts left, right, above and below the obstacle
+			if o.Left > f.Left {
+				next = append(next, Rect{Left: f.Left, Top: f.Top, Right: o.Left, Bottom: f.Bottom})
+			}
+			if o.Right < f.Right {
+				next = append(next, Rect{Left: o.Right, Top: f.Top, Right: f.Right, Bottom: f.Bottom})
+			}
+			if o.Top > f.Top {
+				next = append(next, Rect{Left: f.Left, Top: f.Top, Right: f.Right, Bottom: o.Top})
+			}
+			if o.Bottom < f.Bottom {
+				next = append(next, Rect{Left: f.Left, Top: o.Bottom, Right: f.Right, Bottom: f.Bottom})
+			}
+		}
+		free = removeContainedRects(next)
+	}
+	return free
+}
+
+// removeContainedRects removes rectangles contained in another rectangle of the list.
+// Of identical rectangles, the first one is kept.
+func removeContainedRects(rects []Rect) []Rect {
+	var result []Rect
+	for i, r := range rects {
+		contained := false
+		for j, o := range rects {
+			if i == j || !containsRect(o, r) {
+				continue
+			}
+			if r != o || j < i {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// containsRect checks if rectangle inner lies entirely within rectangle outer
+func containsRect(outer, inner Rect) bool {
+	return inner.Left >= outer.Left && inner.Right <= outer.Right &&
+		inner.Top >= outer.Top && inner.Bottom <= outer.Bottom
+}
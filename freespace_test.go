@@ -0,0 +1,147 @@
+package multimon
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaximalEmptyRects(t *testing.T) {
+	area := Rect{0, 0, 100, 100}
+
+	tests := []struct {
+		name      string
+		obstacles []Rect
+		want      []Rect
+	}{
+		{
+			name: "no obstacles",
+			want: []Rect{{0, 0, 100, 100}},
+		},
+		{
+			name:      "obstacle outside area",
+			obstacles: []Rect{{100, 0, 200, 100}},
+			want:      []Rect{{0, 0, 100, 100}},
+		},
+		{
+			name:      "obstacle in the middle",
+			obstacles: []Rect{{40, 40, 60, 60}},
+			want: []Rect{
+				{0, 0, 40, 100},
+				{60, 0, 100, 100},
+				{0, 0, 100, 40},
+				{0, 60, 100, 100},
+			},
+		},
+		{
+			name:      "obstacle in the corner",
+			obstacles: []Rect{{0, 0, 50, 50}},
+			want: []Rect{
+				{50, 0, 100, 100},
+				{0, 50, 100, 100},
+			},
+		},
+		{
+			name:      "two obstacles",
+			obstacles: []Rect{{0, 0, 50, 50}, {50, 50, 100, 100}},
+			want: []Rect{
+				{50, 0, 100, 50},
+				{0, 50, 50, 100},
+			},
+		},
+		{
+			name:      "area fully covered",
+			obstacles: []Rect{{-10, -10, 110, 110}},
+			want:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maximalEmptyRects(area, tt.obstacles)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaceInFreeSpace(t *testing.T) {
+	monitor := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 0, 1920, 1040},
+		Scale:    1.0,
+	}
+
+	tests := []struct {
+		name          string
+		monitor       *Monitor
+		width, height int
+		windows       []Rect
+		want          Rect
+	}{
+		{
+			name:    "no windows centers",
+			monitor: monitor,
+			width:   400,
+			height:  300,
+			want:    Rect{760, 370, 1160, 670},
+		},
+		{
+			name:    "window elsewhere keeps center",
+			monitor: monitor,
+			width:   400,
+			height:  300,
+			windows: []Rect{{0, 0, 500, 400}},
+			want:    Rect{760, 370, 1160, 670},
+		},
+		{
+			name:    "centered window pushes to nearest free space",
+			monitor: monitor,
+			width:   400,
+			height:  300,
+			windows: []Rect{{500, 200, 1420, 840}},
+			// free areas above and below are too short, left and right are equally close
+			want: Rect{100, 370, 500, 670},
+		},
+		{
+			name:    "left half covered",
+			monitor: monitor,
+			width:   800,
+			height:  600,
+			windows: []Rect{{0, 0, 960, 1040}},
+			want:    Rect{960, 220, 1760, 820},
+		},
+		{
+			name:    "no free space fits, least overlap",
+			monitor: monitor,
+			width:   1000,
+			height:  600,
+			windows: []Rect{{0, 0, 960, 1040}, {960, 0, 1920, 520}},
+			// overlap with the bottom-right free area (960,520)-(1920,1040) is maximized
+			want: Rect{920, 440, 1920, 1040},
+		},
+		{
+			name:    "size clamped to work area",
+			monitor: monitor,
+			width:   2500,
+			height:  600,
+			want:    Rect{0, 220, 1920, 820},
+		},
+		{
+			name:    "nil monitor",
+			monitor: nil,
+			width:   400,
+			height:  300,
+			want:    Rect{0, 0, 400, 300},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlaceInFreeSpace(tt.monitor, tt.width, tt.height, tt.windows)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
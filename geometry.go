@@ -0,0 +1,202 @@
+package multimon
+
+import "math"
+
+// PointF represents a point with fractional coordinates
+type PointF struct {
+	X, Y float64
+}
+
+// RectF represents a rectangle with fractional coordinates
+type RectF struct {
+	Left, Top, Right, Bottom float64
+}
+
+// RoundingMode specifies how fractional coordinates are converted to integers
+type RoundingMode int
+
+const (
+	// RoundNearest rounds to the nearest integer, halfway values away from zero
+	RoundNearest RoundingMode = iota
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+	// RoundCeil rounds toward positive infinity
+	RoundCeil
+	// RoundOutward rounds rect edges so that the result contains the rect:
+	// left and top edges down, right and bottom edges up
+	RoundOutward
+	// RoundInward rounds rect edges so that the result lies within the rect:
+	// left and top edges up, right and bottom edges down
+	RoundInward
+)
+
+// edgeSnapTolerance is the maximum distance in screen units at which
+// SnapToMonitorEdges moves a rect edge onto a monitor edge
+const edgeSnapTolerance = 1.0
+
+// PointToF converts a point to fractional coordinates
+func PointToF(p Point) PointF {
+	return PointF{X: float64(p.X), Y: float64(p.Y)}
+}
+
+// RectToF converts a rect to fractional coordinates
+func RectToF(r Rect) RectF {
+	return RectF{
+		Left:   float64(r.Left),
+		Top:    float64(r.Top),
+		Right:  float64(r.Right),
+		Bottom: float64(r.Bottom),
+	}
+}
+
+// Round converts the point to integer coordinates.
+// RoundOutward and RoundInward are not meaningful for points and behave as RoundNearest.
+func (p PointF) Round(mode RoundingMode) Point {
+	if mode == RoundOutward || mode == RoundInward {
+		mode = RoundNearest
+	}
+	return Point{X: roundValue(p.X, mode, false), Y: roundValue(p.Y, mode, false)}
+}
+
+// Round converts the rect to integer coordinates
+func (r RectF) Round(mode RoundingMode) Rect {
+	return Rect{
+		Left:   roundValue(r.Left, mode, false),
+		Top:    roundValue(r.Top, mode, false),
+		Right:  roundValue(r.Right, mode, true),
+		Bottom: roundValue(r.Bottom, mode, true),
+	}
+}
+
+// roundValue rounds a coordinate; far is true for right and bottom edges
+func roundValue(v float64, mode RoundingMode, far bool) int {
+	switch mode {
+	case RoundFloor:
+		return int(math.Floor(v))
+	case RoundCeil:
+		return int(math.Ceil(v))
+	case RoundOutward:
+		if far {
+			return int(math.Ceil(v))
+		}
+		return int(math.Floor(v))
+	case RoundInward:
+		if far {
+			return int(math.Floor(v))
+		}
+		return int(math.Ceil(v))
+	default:
+		return int(math.Round(v))
+	}
+}
+
+// ScreenToMonitorLogicalRectF converts a rect in screen coordinates to logical
+// coordinates relative to the top-left corner of monitor m's bounds, without rounding
+func ScreenToMonitorLogicalRectF(m Monitor, screen RectF) RectF {
+	left, top := float64(m.Bounds.Left), float64(m.Bounds.Top)
+	return RectF{
+		Left:   (screen.Left - left) / m.Scale,
+		Top:    (screen.Top - top) / m.Scale,
+		Right:  (screen.Right - left) / m.Scale,
+		Bottom: (screen.Bottom - top) / m.Scale,
+	}
+}
+
+// MonitorLogicalToScreenRectF converts a rect in logical coordinates relative
+// to the top-left corner of monitor m's bounds to screen coordinates, without rounding
+func MonitorLogicalToScreenRectF(m Monitor, logical RectF) RectF {
+	left, top := float64(m.Bounds.Left), float64(m.Bounds.Top)
+	return RectF{
+		Left:   left + logical.Left*m.Scale,
+		Top:    top + logical.Top*m.Scale,
+		Right:  left + logical.Right*m.Scale,
+		Bottom: top + logical.Bottom*m.Scale,
+	}
+}
+
+// ScreenToMonitorLogicalPointF converts a screen point to logical coordinates
+// relative to the top-left corner of monitor m's bounds, without rounding
+func ScreenToMonitorLogicalPointF(m Monitor, screen PointF) PointF {
+	return PointF{
+		X: (screen.X - float64(m.Bounds.Left)) / m.Scale,
+		Y: (screen.Y - float64(m.Bounds.Top)) / m.Scale,
+	}
+}
+
+// MonitorLogicalToScreenPointF converts a point in logical coordinates relative
+// to the top-left corner of monitor m's bounds to screen coordinates, without rounding
+func MonitorLogicalToScreenPointF(m Monitor, logical PointF) PointF {
+	return PointF{
+		X: float64(m.Bounds.Left) + logical.X*m.Scale,
+		Y: float64(m.Bounds.Top) + logical.Y*m.Scale,
+	}
+}
+
+// ScreenToGlobalLogicalRectF converts a rect in screen coordinates to the logical
+// layout without rounding, see ScreenToGlobalLogicalRect.
+// Returns ErrNoMonitors if the layout is empty.
+func (l LogicalLayout) ScreenToGlobalLogicalRectF(screen RectF) (RectF, error) {
+	lm := l.findMonitor(screen.Round(RoundOutward), false)
+	if lm == nil {
+		return screen, ErrNoMonitors
+	}
+	r := ScreenToMonitorLogicalRectF(*lm.Monitor, screen)
+	return offsetRectF(r, float64(lm.Logical.Left), float64(lm.Logical.Top)), nil
+}
+
+// GlobalLogicalToScreenRectF converts a rect in the logical layout to screen
+// coordinates without rounding, see GlobalLogicalToScreenRect.
+// Returns ErrNoMonitors if the layout is empty.
+func (l LogicalLayout) GlobalLogicalToScreenRectF(logical RectF) (RectF, error) {
+	lm := l.findMonitor(logical.Round(RoundOutward), true)
+	if lm == nil {
+		return logical, ErrNoMonitors
+	}
+	r := offsetRectF(logical, -float64(lm.Logical.Left), -float64(lm.Logical.Top))
+	return MonitorLogicalToScreenRectF(*lm.Monitor, r), nil
+}
+
+// SnapToMonitorEdges rounds a rect in screen coordinates, moving every edge
+// that lies within one screen unit of a monitor's bounds or work area edge
+// exactly onto that edge. Edges shared by adjacent monitors are thus
+// preserved regardless of the rounding mode; other edges are rounded with mode.
+// Only monitor edges overlapping the rect perpendicularly are considered.
+func SnapToMonitorEdges(monitors []Monitor, r RectF, mode RoundingMode) Rect {
+	result := r.Round(mode)
+	snap := func(v float64, rounded int, lo, hi float64, vertical bool) int {
+		best, bestDistance := rounded, edgeSnapTolerance
+		for _, m := range monitors {
+			for _, b := range []Rect{m.Bounds, m.WorkArea} {
+				edgeLo, edgeHi, e1, e2 := b.Top, b.Bottom, b.Left, b.Right
+				if !vertical {
+					edgeLo, edgeHi, e1, e2 = b.Left, b.Right, b.Top, b.Bottom
+				}
+				if float64(edgeHi) <= lo || float64(edgeLo) >= hi {
+					continue
+				}
+				for _, e := range []int{e1, e2} {
+					if d := math.Abs(v - float64(e)); d < bestDistance {
+						best, bestDistance = e, d
+					}
+				}
+			}
+		}
+		return best
+	}
+
+	result.Left = snap(r.Left, result.Left, r.Top, r.Bottom, true)
+	result.Right = snap(r.Right, result.Right, r.Top, r.Bottom, true)
+	result.Top = snap(r.Top, result.Top, r.Left, r.Right, false)
+	result.Bottom = snap(r.Bottom, result.Bottom, r.Left, r.Right, false)
+	return result
+}
+
+// offsetRectF moves a rect by dx, dy
+func offsetRectF(r RectF, dx, dy float64) RectF {
+	return RectF{
+		Left:   r.Left + dx,
+		Top:    r.Top + dy,
+		Right:  r.Right + dx,
+		Bottom: r.Bottom + dy,
+	}
+}
@@ -0,0 +1,164 @@
+package multimon
+
+import "testing"
+
+func TestRectFRound(t *testing.T) {
+	r := RectF{-1.5, 0.4, 10.5, 20.6}
+
+	tests := []struct {
+		name string
+		mode RoundingMode
+		want Rect
+	}{
+		{"nearest", RoundNearest, Rect{-2, 0, 11, 21}},
+		{"floor", RoundFloor, Rect{-2, 0, 10, 20}},
+		{"ceil", RoundCeil, Rect{-1, 1, 11, 21}},
+		{"outward", RoundOutward, Rect{-2, 0, 11, 21}},
+		{"inward", RoundInward, Rect{-1, 1, 10, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Round(tt.mode); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointFRound(t *testing.T) {
+	p := PointF{-1.5, 2.5}
+
+	tests := []struct {
+		name string
+		mode RoundingMode
+		want Point
+	}{
+		{"nearest", RoundNearest, Point{-2, 3}},
+		{"floor", RoundFloor, Point{-2, 2}},
+		{"ceil", RoundCeil, Point{-1, 3}},
+		{"outward", RoundOutward, Point{-2, 3}},
+		{"inward", RoundInward, Point{-2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.Round(tt.mode); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := PointToF(Point{3, -4}); got != (PointF{3, -4}) {
+		t.Errorf("PointToF() = %v, want %v", got, PointF{3, -4})
+	}
+	if got := RectToF(Rect{1, 2, 3, 4}); got != (RectF{1, 2, 3, 4}) {
+		t.Errorf("RectToF() = %v, want %v", got, RectF{1, 2, 3, 4})
+	}
+}
+
+func TestMonitorLogicalConversionF(t *testing.T) {
+	m := Monitor{
+		Bounds:   Rect{1920, 0, 4480, 1440},
+		WorkArea: Rect{1920, 0, 4480, 1440},
+		Scale:    1.5,
+	}
+
+	rects := []Rect{
+		{1921, 1, 2921, 1001},
+		{1920, 0, 4480, 1440},
+		{1700, -7, 2003, 95},
+	}
+	for _, screen := range rects {
+		logical := ScreenToMonitorLogicalRectF(m, RectToF(screen))
+		if got := MonitorLogicalToScreenRectF(m, logical).Round(RoundNearest); got != screen {
+			t.Errorf("round trip of %v: got %v", screen, got)
+		}
+
+		p := PointToF(Point{screen.Left, screen.Top})
+		if got := MonitorLogicalToScreenPointF(m, ScreenToMonitorLogicalPointF(m, p)).Round(RoundNearest); got != (Point{screen.Left, screen.Top}) {
+			t.Errorf("point round trip of %v: got %v", p, got)
+		}
+	}
+
+	t.Run("negative coordinates", func(t *testing.T) {
+		origin := Monitor{Bounds: Rect{0, 0, 3840, 2160}, WorkArea: Rect{0, 0, 3840, 2160}, Scale: 2.0}
+		got := ScreenToMonitorLogicalRectF(origin, RectF{-3, -3, 3, 3}).Round(RoundOutward)
+		if want := (Rect{-2, -2, 2, 2}); got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestLogicalLayoutConversionF(t *testing.T) {
+	layout, err := NewLogicalLayout([]Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1080}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 5760, 2160}, WorkArea: Rect{1920, 0, 5760, 2160}, Scale: 2.0},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	screen := RectF{1921, 1, 2921, 1001}
+	logical, err := layout.ScreenToGlobalLogicalRectF(screen)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (RectF{1920.5, 0.5, 2420.5, 500.5}); logical != want {
+		t.Errorf("ScreenToGlobalLogicalRectF() = %v, want %v", logical, want)
+	}
+	back, err := layout.GlobalLogicalToScreenRectF(logical)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if back != screen {
+		t.Errorf("GlobalLogicalToScreenRectF() = %v, want %v", back, screen)
+	}
+}
+
+func TestSnapToMonitorEdges(t *testing.T) {
+	monitors := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 4480, 1440}, WorkArea: Rect{1920, 0, 4480, 1440}, Scale: 1.25},
+	}
+
+	tests := []struct {
+		name string
+		r    RectF
+		mode RoundingMode
+		want Rect
+	}{
+		{
+			name: "shared edge from the left",
+			r:    RectF{1000.2, 100.3, 1919.6, 600.7},
+			mode: RoundFloor,
+			want: Rect{1000, 100, 1920, 600},
+		},
+		{
+			name: "shared edge from the right",
+			r:    RectF{1920.4, 100, 2500.5, 600},
+			mode: RoundCeil,
+			want: Rect{1920, 100, 2501, 600},
+		},
+		{
+			name: "work area edge",
+			r:    RectF{100, 540.3, 500, 1039.2},
+			mode: RoundNearest,
+			want: Rect{100, 540, 500, 1040},
+		},
+		{
+			name: "edge not overlapping perpendicularly",
+			r:    RectF{1919.5, 2000, 2100, 2100},
+			mode: RoundFloor,
+			want: Rect{1919, 2000, 2100, 2100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SnapToMonitorEdges(monitors, tt.r, tt.mode); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package multimon
+
+// Gravity specifies a reference point of a rectangle that a window is aligned to
+type Gravity int
+
+const (
+	// GravityCenter aligns the window center with the center of the area
+	GravityCenter Gravity = iota
+	// GravityNorthWest aligns the window with the top-left corner
+	GravityNorthWest
+	// GravityNorth aligns the window with the center of the top edge
+	GravityNorth
+	// GravityNorthEast aligns the window with the top-right corner
+	GravityNorthEast
+	// GravityWest aligns the window with the center of the left edge
+	GravityWest
+	// GravityEast aligns the window with the center of the right edge
+	GravityEast
+	// GravitySouthWest aligns the window with the bottom-left corner
+	GravitySouthWest
+	// GravitySouth aligns the window with the center of the bottom edge
+	GravitySouth
+	// GravitySouthEast aligns the window with the bottom-right corner
+	GravitySouthEast
+)
+
+// horizontal returns -1 for west, 0 for center and 1 for east gravities
+func (g Gravity) horizontal() int {
+	switch g {
+	case GravityNorthWest, GravityWest, GravitySouthWest:
+		return -1
+	case GravityNorthEast, GravityEast, GravitySouthEast:
+		return 1
+	}
+	return 0
+}
+
+// vertical returns -1 for north, 0 for center and 1 for south gravities
+func (g Gravity) vertical() int {
+	switch g {
+	case GravityNorthWest, GravityNorth, GravityNorthEast:
+		return -1
+	case GravitySouthWest, GravitySouth, GravitySouthEast:
+		return 1
+	}
+	return 0
+}
+
+// PlaceAtGravity positions a window at one of the nine gravity points of
+// a monitor's work area.
+//
+// Parameters:
+// - width, height: window size in screen units (e.g. from CalcPlacementSize)
+// - offsetX, offsetY: offsets from the gravity point in logical units
+// - margin: minimum distance from work area edges in logical units
+//
+// The sign convention of offsetX depends on the horizontal part of the gravity
+// (offsetY follows the same rules, with north, south and the top and bottom
+// edges in place of west, east and the left and right edges):
+// - West gravities: a positive or zero offset is the distance from the left
+// edge; a negative offset is the distance from the right edge, as in X11
+// geometry strings
+// - East gravities: the offset is the distance from the right edge and its
+// sign is ignored, so +10 and -10 give the same position
+// - Centered gravities: a positive offset shifts the window right,
+// a negative offset shifts it left
+// The window is then clamped to the work area minus margins; if it does not
+// fit within margins, it is allowed to use the margin area.
+// If m is nil, the window is placed at the origin.
+//
+// Returns a Rect with the window position and size in screen units.
+func PlaceAtGravity(m *Monitor, gravity Gravity, width, height, offsetX, offsetY, margin int) Rect {
+	if m == nil {
+		return Rect{Right: width, Bottom: height}
+	}
+
+	screenOffsetX := int(float64(offsetX) * m.Scale)
+	screenOffsetY := int(float64(offsetY) * m.Scale)
+	screenMargin := int(float64(margin) * m.Scale)
+
+	wa := m.WorkArea
+	area := Rect{
+		Left:   wa.Left + screenMargin,
+		Top:    wa.Top + screenMargin,
+		Right:  wa.Right - screenMargin,
+		Bottom: wa.Bottom - screenMargin,
+	}
+	r := alignInRect(area, gravity, width, height, screenOffsetX, screenOffsetY)
+
+	left, newWidth := fitWithMargin(r.Left, width, area.Left, area.Right, wa.Left, wa.Right)
+	top, newHeight := fitWithMargin(r.Top, height, area.Top, area.Bottom, wa.Top, wa.Bottom)
+	return Rect{
+		Left:   left,
+		Top:    top,
+		Right:  left + newWidth,
+		Bottom: top + newHeight,
+	}
+}
+
+// alignInRect positions a rectangle of the given size at a gravity point of area,
+// see PlaceAtGravity for the meaning of offsets. The result is not clamped.
+func alignInRect(area Rect, gravity Gravity, width, height, offsetX, offsetY int) Rect {
+	left := alignDimension(area.Left, area.Right, width, gravity.horizontal(), offsetX)
+	top := alignDimension(area.Top, area.Bottom, height, gravity.vertical(), offsetY)
+	return Rect{
+		Left:   left,
+		Top:    top,
+		Right:  left + width,
+		Bottom: top + height,
+	}
+}
+
+// alignDimension calculates the position of a dimension aligned within [lo, hi]
+func alignDimension(lo, hi, size, align, offset int) int {
+	switch {
+	case align == 0:
+		return lo + (hi-lo)/2 - size/2 + offset
+	case align < 0 && offset >= 0:
+		return lo + offset
+	case offset < 0:
+		return hi - size + offset
+	default:
+		return hi - size - offset
+	}
+}
+
+// fitWithMargin fits a dimension within [innerMin, innerMax] if it is large enough,
+// otherwise within [outerMin, outerMax]
+func fitWithMargin(pos, size, innerMin, innerMax, outerMin, outerMax int) (int, int) {
+	if size <= innerMax-innerMin {
+		return fitRectDimension(pos, size, innerMin, innerMax)
+	}
+	return fitRectDimension(pos, size, outerMin, outerMax)
+}
@@ -0,0 +1,158 @@
+package multimon
+
+import "testing"
+
+func TestPlaceAtGravity(t *testing.T) {
+	monitor := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 40, 1920, 1040},
+		Scale:    2.0,
+	}
+
+	tests := []struct {
+		name    string
+		gravity Gravity
+		width   int
+		height  int
+		offsetX int
+		offsetY int
+		margin  int
+		want    Rect
+	}{
+		// All nine gravity points without offsets
+		{name: "north-west", gravity: GravityNorthWest, width: 400, height: 200, want: Rect{0, 40, 400, 240}},
+		{name: "north", gravity: GravityNorth, width: 400, height: 200, want: Rect{760, 40, 1160, 240}},
+		{name: "north-east", gravity: GravityNorthEast, width: 400, height: 200, want: Rect{1520, 40, 1920, 240}},
+		{name: "west", gravity: GravityWest, width: 400, height: 200, want: Rect{0, 440, 400, 640}},
+		{name: "center", gravity: GravityCenter, width: 400, height: 200, want: Rect{760, 440, 1160, 640}},
+		{name: "east", gravity: GravityEast, width: 400, height: 200, want: Rect{1520, 440, 1920, 640}},
+		{name: "south-west", gravity: GravitySouthWest, width: 400, height: 200, want: Rect{0, 840, 400, 1040}},
+		{name: "south", gravity: GravitySouth, width: 400, height: 200, want: Rect{760, 840, 1160, 1040}},
+		{name: "south-east", gravity: GravitySouthEast, width: 400, height: 200, want: Rect{1520, 840, 1920, 1040}},
+
+		// Offsets in logical units
+		{
+			name:    "north-west with offsets",
+			gravity: GravityNorthWest,
+			width:   400,
+			height:  200,
+			offsetX: 10,
+			offsetY: 20,
+			want:    Rect{20, 80, 420, 280},
+		},
+		{
+			name:    "south-east offsets are inward",
+			gravity: GravitySouthEast,
+			width:   400,
+			height:  200,
+			offsetX: 10,
+			offsetY: 20,
+			want:    Rect{1500, 800, 1900, 1000},
+		},
+		{
+			name:    "negative offsets measure from right and bottom",
+			gravity: GravityNorthWest,
+			width:   400,
+			height:  200,
+			offsetX: -10,
+			offsetY: -20,
+			want:    Rect{1500, 800, 1900, 1000},
+		},
+		{
+			name:    "negative offsets with east gravity",
+			gravity: GravityEast,
+			width:   400,
+			height:  200,
+			offsetX: -10,
+			want:    Rect{1500, 440, 1900, 640},
+		},
+		{
+			name:    "positive offsets with east gravity",
+			gravity: GravityEast,
+			width:   400,
+			height:  200,
+			offsetX: 10,
+			want:    Rect{1500, 440, 1900, 640},
+		},
+		{
+			name:    "negative offsets with south gravity",
+			gravity: GravitySouth,
+			width:   400,
+			height:  200,
+			offsetY: -20,
+			want:    Rect{760, 800, 1160, 1000},
+		},
+		{
+			name:    "center offsets shift",
+			gravity: GravityCenter,
+			width:   400,
+			height:  200,
+			offsetX: -50,
+			offsetY: 50,
+			want:    Rect{660, 540, 1060, 740},
+		},
+
+		// Margins
+		{
+			name:    "margin",
+			gravity: GravityNorthEast,
+			width:   400,
+			height:  200,
+			margin:  10,
+			want:    Rect{1500, 60, 1900, 260},
+		},
+		{
+			name:    "margin and offset",
+			gravity: GravitySouthWest,
+			width:   400,
+			height:  200,
+			offsetX: 5,
+			offsetY: 5,
+			margin:  10,
+			want:    Rect{30, 810, 430, 1010},
+		},
+
+		// Clamping
+		{
+			name:    "offset beyond work area is clamped",
+			gravity: GravityNorthWest,
+			width:   400,
+			height:  200,
+			offsetX: 2000,
+			offsetY: 2000,
+			margin:  10,
+			want:    Rect{1500, 820, 1900, 1020},
+		},
+		{
+			name:    "window larger than margin area uses margin area",
+			gravity: GravityNorthWest,
+			width:   1910,
+			height:  200,
+			margin:  10,
+			want:    Rect{10, 60, 1920, 260},
+		},
+		{
+			name:    "window larger than work area is clamped",
+			gravity: GravityCenter,
+			width:   2000,
+			height:  1200,
+			want:    Rect{0, 40, 1920, 1040},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlaceAtGravity(monitor, tt.gravity, tt.width, tt.height, tt.offsetX, tt.offsetY, tt.margin)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("nil monitor", func(t *testing.T) {
+		got := PlaceAtGravity(nil, GravitySouthEast, 400, 200, 10, 10, 10)
+		if want := (Rect{0, 0, 400, 200}); got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
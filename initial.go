@@ -27,6 +27,12 @@ func CalcPlacementSize(m *Monitor, desiredWidth, desiredHeight, minWidth, minHei
 	screenDesiredHeight := int(float64(desiredHeight) * m.Scale)
 	screenMargin := int(float64(margin) * m.Scale)
 
+	return calcScreenPlacementSize(m, screenDesiredWidth, screenDesiredHeight, screenMinWidth, screenMinHeight, screenMargin)
+}
+
+// calcScreenPlacementSize implements CalcPlacementSize for parameters
+// that are already converted to screen units
+func calcScreenPlacementSize(m *Monitor, screenDesiredWidth, screenDesiredHeight, screenMinWidth, screenMinHeight, screenMargin int) (width, height int) {
 	// First try to satisfy desired size with margins
 	availWidth := m.WorkArea.Right - m.WorkArea.Left - 2*screenMargin
 	availHeight := m.WorkArea.Bottom - m.WorkArea.Top - 2*screenMargin
@@ -56,34 +62,22 @@ func CalcPlacementSize(m *Monitor, desiredWidth, desiredHeight, minWidth, minHei
 // Returns a Rect with the calculated window position and size in screen units,
 // and the scale factor of the selected monitor (1.0 if no monitor is available).
 func InitialPlacement(desiredWidth, desiredHeight, minWidth, minHeight, margin int) (Rect, float64) {
-	monitors := GetMonitors()
-	if len(monitors) == 0 {
-		width, height := CalcPlacementSize(nil, desiredWidth, desiredHeight, minWidth, minHeight, margin)
-		return Rect{
-			Left:   0,
-			Top:    0,
-			Right:  width,
-			Bottom: height,
-		}, 1.0
-	}
-
-	// Find default mon (containing 0,0 or first available)
-	mon := FindPrimaryMonitor(monitors)
-	monitorScale := 1.0
-	if mon != nil {
-		monitorScale = mon.Scale
-	}
-
-	width, height := CalcPlacementSize(mon, desiredWidth, desiredHeight, minWidth, minHeight, margin)
+	// Find default monitor (containing 0,0 or first available)
+	return placeOnMonitor(FindPrimaryMonitor(GetMonitors()), desiredWidth, desiredHeight, minWidth, minHeight, margin)
+}
 
-	// Center the window in work area
-	centerX := (mon.WorkArea.Left + mon.WorkArea.Right) / 2
-	centerY := (mon.WorkArea.Top + mon.WorkArea.Bottom) / 2
+// placeOnMonitor sizes a window with CalcPlacementSize and centers it in the
+// work area of monitor m, see centerOnMonitor
+func placeOnMonitor(m *Monitor, desiredWidth, desiredHeight, minWidth, minHeight, margin int) (Rect, float64) {
+	width, height := CalcPlacementSize(m, desiredWidth, desiredHeight, minWidth, minHeight, margin)
+	return centerOnMonitor(m, width, height)
+}
 
-	return Rect{
-		Left:   centerX - width/2,
-		Top:    centerY - height/2,
-		Right:  centerX + (width+1)/2,
-		Bottom: centerY + (height+1)/2,
-	}, monitorScale
+// centerOnMonitor centers a window of the given size in screen units in the
+// work area of monitor m. If m is nil, the window is placed at the origin with 1.0 scale.
+func centerOnMonitor(m *Monitor, width, height int) (Rect, float64) {
+	if m == nil {
+		return Rect{Right: width, Bottom: height}, 1.0
+	}
+	return centerRect(Rect{Right: width, Bottom: height}, m.WorkArea), m.Scale
 }
@@ -0,0 +1,60 @@
+package multimon
+
+import "github.com/adnsv/multimon/units"
+
+// CalcPlacementSizeDims is a variant of CalcPlacementSize that accepts dimensions
+// with units. Dimensions are resolved on monitor m:
+// - Pixels are logical units, converted to screen units with the monitor's scale
+// - Em units are multiples of the system font em-height (units.GetEmHeight),
+// which is in logical units on all platforms
+// - Percentages are relative to the monitor's work area; for margin, the
+// smaller of the work area's width and height is used
+//
+// If m is nil, percentages resolve to zero and a 1:1 scale is assumed.
+// Returns width and height in screen units.
+func CalcPlacementSizeDims(m *Monitor, desiredWidth, desiredHeight, minWidth, minHeight, margin units.Dimension) (width, height int) {
+	return calcPlacementSizeDims(m, units.GetEmHeight(), desiredWidth, desiredHeight, minWidth, minHeight, margin)
+}
+
+// calcPlacementSizeDims implements CalcPlacementSizeDims with the given em-height
+func calcPlacementSizeDims(m *Monitor, emHeight int, desiredWidth, desiredHeight, minWidth, minHeight, margin units.Dimension) (width, height int) {
+	ctx := resolveContext(m, emHeight)
+	if m == nil {
+		width = max(minWidth.ResolveWidth(ctx), desiredWidth.ResolveWidth(ctx))
+		height = max(minHeight.ResolveHeight(ctx), desiredHeight.ResolveHeight(ctx))
+		return
+	}
+
+	return calcScreenPlacementSize(m,
+		desiredWidth.ResolveWidth(ctx),
+		desiredHeight.ResolveHeight(ctx),
+		minWidth.ResolveWidth(ctx),
+		minHeight.ResolveHeight(ctx),
+		min(margin.ResolveWidth(ctx), margin.ResolveHeight(ctx)))
+}
+
+// InitialPlacementDims is a variant of InitialPlacement that accepts dimensions
+// with units, see CalcPlacementSizeDims for how they are resolved.
+//
+// Returns a Rect with the calculated window position and size in screen units,
+// and the scale factor of the selected monitor (1.0 if no monitor is available).
+func InitialPlacementDims(desiredWidth, desiredHeight, minWidth, minHeight, margin units.Dimension) (Rect, float64) {
+	mon := FindPrimaryMonitor(GetMonitors())
+	width, height := CalcPlacementSizeDims(mon, desiredWidth, desiredHeight, minWidth, minHeight, margin)
+	return centerOnMonitor(mon, width, height)
+}
+
+// resolveContext returns the context for resolving dimensions to screen units
+// on monitor m: percentages against its work area, pixels and em units scaled
+// with its scale factor. If m is nil, a 1:1 scale and an empty work area are used.
+func resolveContext(m *Monitor, emHeight int) units.ResolveContext {
+	ctx := units.ResolveContext{EmHeight: emHeight}
+	if m != nil {
+		ctx.WorkArea = units.WorkArea{
+			Width:  m.WorkArea.Right - m.WorkArea.Left,
+			Height: m.WorkArea.Bottom - m.WorkArea.Top,
+		}
+		ctx.Scale = m.Scale
+	}
+	return ctx
+}
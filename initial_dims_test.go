@@ -0,0 +1,105 @@
+package multimon
+
+import (
+	"testing"
+
+	"github.com/adnsv/multimon/units"
+)
+
+func TestCalcPlacementSizeDims(t *testing.T) {
+	monitor := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 40, 1920, 1040},
+		Scale:    1.5,
+	}
+
+	tests := []struct {
+		name          string
+		monitor       *Monitor
+		desiredWidth  units.Dimension
+		desiredHeight units.Dimension
+		minWidth      units.Dimension
+		minHeight     units.Dimension
+		margin        units.Dimension
+		wantWidth     int
+		wantHeight    int
+	}{
+		{
+			name:          "pixels match CalcPlacementSize",
+			monitor:       monitor,
+			desiredWidth:  units.Pixels(800),
+			desiredHeight: units.Pixels(600),
+			minWidth:      units.Pixels(400),
+			minHeight:     units.Pixels(300),
+			margin:        units.Pixels(20),
+			wantWidth:     1200, // 800 * 1.5
+			wantHeight:    900,  // 600 * 1.5
+		},
+		{
+			name:          "em units",
+			monitor:       monitor,
+			desiredWidth:  units.Ems(40),
+			desiredHeight: units.Ems(25),
+			margin:        units.Ems(1),
+			wantWidth:     960, // 40 * 16 * 1.5
+			wantHeight:    600, // 25 * 16 * 1.5
+		},
+		{
+			name:          "percent of work area",
+			monitor:       monitor,
+			desiredWidth:  units.Pct(80),
+			desiredHeight: units.Pct(70),
+			wantWidth:     1536, // 80% of 1920
+			wantHeight:    700,  // 70% of 1000
+		},
+		{
+			name:          "percent margin uses smaller dimension",
+			monitor:       monitor,
+			desiredWidth:  units.Pct(100),
+			desiredHeight: units.Pct(100),
+			margin:        units.Pct(5),
+			wantWidth:     1820, // 1920 - 2 * 50
+			wantHeight:    900,  // 1000 - 2 * 50
+		},
+		{
+			name:          "em minimum exceeds percent desired",
+			monitor:       monitor,
+			desiredWidth:  units.Pct(10),
+			desiredHeight: units.Pct(10),
+			minWidth:      units.Ems(20),
+			minHeight:     units.Ems(10),
+			wantWidth:     480, // 20 * 16 * 1.5
+			wantHeight:    240, // 10 * 16 * 1.5
+		},
+		{
+			name:          "minimum may use margin area",
+			monitor:       monitor,
+			desiredWidth:  units.Pct(50),
+			desiredHeight: units.Pct(50),
+			minWidth:      units.Pct(100),
+			minHeight:     units.Pct(100),
+			margin:        units.Pixels(20),
+			wantWidth:     1920,
+			wantHeight:    1000,
+		},
+		{
+			name:          "nil monitor",
+			monitor:       nil,
+			desiredWidth:  units.Ems(40),
+			desiredHeight: units.Pct(50),
+			minWidth:      units.Pixels(400),
+			minHeight:     units.Pixels(300),
+			wantWidth:     640, // 40 * 16
+			wantHeight:    300, // percent resolves to zero
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotWidth, gotHeight := calcPlacementSizeDims(tt.monitor, 16, tt.desiredWidth, tt.desiredHeight, tt.minWidth, tt.minHeight, tt.margin)
+			if gotWidth != tt.wantWidth || gotHeight != tt.wantHeight {
+				t.Errorf("CalcPlacementSizeDims() = (%v, %v), want (%v, %v)", gotWidth, gotHeight, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
@@ -137,3 +137,23 @@ func TestCalcPlacementSize(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaceOnMonitor(t *testing.T) {
+	m := &Monitor{
+		Bounds:   Rect{1920, 0, 3840, 1080},
+		WorkArea: Rect{1920, 40, 3840, 1080},
+		Scale:    1.5,
+	}
+
+	got, scale := placeOnMonitor(m, 800, 600, 0, 0, 0)
+	want := Rect{2280, 110, 3480, 1010}
+	if got != want || scale != 1.5 {
+		t.Errorf("got (%v, %v), want (%v, 1.5)", got, scale, want)
+	}
+
+	got, scale = placeOnMonitor(nil, 800, 600, 0, 0, 0)
+	want = Rect{0, 0, 800, 600}
+	if got != want || scale != 1.0 {
+		t.Errorf("got (%v, %v), want (%v, 1.0)", got, scale, want)
+	}
+}
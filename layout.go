@@ -0,0 +1,174 @@
+package multimon
+
+import "math"
+
+// LogicalMonitor is a monitor with its rect in the global logical desktop
+type LogicalMonitor struct {
+	Monitor *Monitor
+	Logical Rect // Monitor bounds in logical units
+}
+
+// LogicalLayout is the global logical desktop: a DPI-independent coordinate
+// space covering all monitors, similar to the Wayland and macOS desktop layouts.
+// Every monitor occupies a rect of its logical size (bounds divided by scale),
+// and monitors that are adjacent in screen space remain adjacent in logical space.
+// ScreenToGlobalLogicalRect and the other package-level global conversions
+// use the same model.
+type LogicalLayout struct {
+	Monitors []LogicalMonitor
+}
+
+// NewLogicalLayout builds a logical layout from monitors:
+// 1. The primary monitor keeps its screen origin
+// 2. Monitors sharing an edge with an already placed monitor are placed next
+// to it in breadth-first order; their offset along the shared edge is
+// converted with the scale of the placed monitor
+// 3. Monitors not connected to the primary monitor keep their screen origin
+//
+// Invalid monitors are skipped. Layouts in which a monitor touches several
+// placed monitors with different scales may produce logical gaps or overlaps;
+// adjacency to the monitor it is placed next to is always kept.
+// Returns ErrNoMonitors if no valid monitors are available.
+func NewLogicalLayout(monitors []Monitor) (LogicalLayout, error) {
+	var valid []*Monitor
+	for i := range monitors {
+		if validateMonitor(monitors[i]) == nil {
+			valid = append(valid, &monitors[i])
+		}
+	}
+	if len(valid) == 0 {
+		return LogicalLayout{}, ErrNoMonitors
+	}
+
+	logical := make([]Rect, len(valid))
+	placed := make([]bool, len(valid))
+	place := func(i, left, top int) {
+		b := valid[i].Bounds
+		logical[i] = Rect{
+			Left:   left,
+			Top:    top,
+			Right:  left + int(float64(b.Right-b.Left)/valid[i].Scale),
+			Bottom: top + int(float64(b.Bottom-b.Top)/valid[i].Scale),
+		}
+		placed[i] = true
+	}
+
+	primary := 0
+	if p := FindPrimaryMonitor(monitors); p != nil {
+		for i, m := range valid {
+			if m == p {
+				primary = i
+			}
+		}
+	}
+
+	for start := primary; start >= 0; start = firstUnplaced(placed) {
+		place(start, valid[start].Bounds.Left, valid[start].Bounds.Top)
+		queue := []int{start}
+		for len(queue) > 0 {
+			p := queue[0]
+			queue = queue[1:]
+			pb, pl, ps := valid[p].Bounds, logical[p], valid[p].Scale
+			for q := range valid {
+				if placed[q] {
+					continue
+				}
+				qb := valid[q].Bounds
+				offsetX := int(math.Floor(float64(qb.Left-pb.Left) / ps))
+				offsetY := int(math.Floor(float64(qb.Top-pb.Top) / ps))
+				overlapsX := qb.Left < pb.Right && pb.Left < qb.Right
+				overlapsY := qb.Top < pb.Bottom && pb.Top < qb.Bottom
+
+				switch {
+				case qb.Left == pb.Right && overlapsY:
+					place(q, pl.Right, pl.Top+offsetY)
+				case qb.Right == pb.Left && overlapsY:
+					qWidth := int(float64(qb.Right-qb.Left) / valid[q].Scale)
+					place(q, pl.Left-qWidth, pl.Top+offsetY)
+				case qb.Top == pb.Bottom && overlapsX:
+					place(q, pl.Left+offsetX, pl.Bottom)
+				case qb.Bottom == pb.Top && overlapsX:
+					qHeight := int(float64(qb.Bottom-qb.Top) / valid[q].Scale)
+					place(q, pl.Left+offsetX, pl.Top-qHeight)
+				default:
+					continue
+				}
+				queue = append(queue, q)
+			}
+		}
+	}
+
+	layout := LogicalLayout{Monitors: make([]LogicalMonitor, len(valid))}
+	for i, m := range valid {
+		layout.Monitors[i] = LogicalMonitor{Monitor: m, Logical: logical[i]}
+	}
+	return layout, nil
+}
+
+// firstUnplaced returns the index of the first false value, or -1
+func firstUnplaced(placed []bool) int {
+	for i, p := range placed {
+		if !p {
+			return i
+		}
+	}
+	return -1
+}
+
+// ScreenToGlobalLogicalRect converts a rect in screen coordinates to the
+// logical layout, using the monitor that holds most of it.
+// Returns ErrNoMonitors if the layout is empty.
+func (l LogicalLayout) ScreenToGlobalLogicalRect(screen Rect) (Rect, error) {
+	lm := l.findMonitor(screen, false)
+	if lm == nil {
+		return screen, ErrNoMonitors
+	}
+	r := ScreenToMonitorLogicalRect(*lm.Monitor, screen)
+	return offsetRect(r, lm.Logical.Left, lm.Logical.Top), nil
+}
+
+// GlobalLogicalToScreenRect converts a rect in the logical layout to screen
+// coordinates, using the monitor whose logical rect holds most of it.
+// Returns ErrNoMonitors if the layout is empty.
+func (l LogicalLayout) GlobalLogicalToScreenRect(logical Rect) (Rect, error) {
+	lm := l.findMonitor(logical, true)
+	if lm == nil {
+		return logical, ErrNoMonitors
+	}
+	r := offsetRect(logical, -lm.Logical.Left, -lm.Logical.Top)
+	return MonitorLogicalToScreenRect(*lm.Monitor, r), nil
+}
+
+// ScreenToGlobalLogicalPoint converts a screen point to the logical layout.
+// Returns ErrNoMonitors if the layout is empty.
+func (l LogicalLayout) ScreenToGlobalLogicalPoint(x, y int) (Point, error) {
+	r, err := l.ScreenToGlobalLogicalRect(Rect{Left: x, Top: y, Right: x + 1, Bottom: y + 1})
+	return Point{X: r.Left, Y: r.Top}, err
+}
+
+// GlobalLogicalToScreenPoint converts a point in the logical layout to screen coordinates.
+// Returns ErrNoMonitors if the layout is empty.
+func (l LogicalLayout) GlobalLogicalToScreenPoint(x, y int) (Point, error) {
+	r, err := l.GlobalLogicalToScreenRect(Rect{Left: x, Top: y, Right: x + 1, Bottom: y + 1})
+	return Point{X: r.Left, Y: r.Top}, err
+}
+
+// findMonitor finds the monitor whose screen bounds (or logical rect) have
+// the largest overlap with r, or the nearest one if nothing overlaps
+func (l LogicalLayout) findMonitor(r Rect, logical bool) *LogicalMonitor {
+	var best *LogicalMonitor
+	bestOverlap, bestDistance := 0, 0
+	for i := range l.Monitors {
+		lm := &l.Monitors[i]
+		area := lm.Monitor.Bounds
+		if logical {
+			area = lm.Logical
+		}
+		overlap := getOverlapArea(r, area)
+		distance := getEdgeDistance(r, area)
+		if best == nil || overlap > bestOverlap || (overlap == 0 && bestOverlap == 0 && distance < bestDistance) {
+			best, bestOverlap, bestDistance = lm, overlap, distance
+		}
+	}
+	return best
+}
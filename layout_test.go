@@ -0,0 +1,182 @@
+package multimon
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// layoutMonitor creates a monitor with the given bounds and scale
+func layoutMonitor(bounds Rect, scale float64) Monitor {
+	return Monitor{Bounds: bounds, WorkArea: bounds, Scale: scale}
+}
+
+func TestNewLogicalLayout(t *testing.T) {
+	tests := []struct {
+		name     string
+		monitors []Monitor
+		want     []Rect
+	}{
+		{
+			name: "200% monitor on the right",
+			monitors: []Monitor{
+				layoutMonitor(Rect{0, 0, 1920, 1080}, 1.0),
+				layoutMonitor(Rect{1920, 0, 5760, 2160}, 2.0),
+			},
+			want: []Rect{{0, 0, 1920, 1080}, {1920, 0, 3840, 1080}},
+		},
+		{
+			name: "200% monitor on the left",
+			monitors: []Monitor{
+				layoutMonitor(Rect{-3840, 0, 0, 2160}, 2.0),
+				layoutMonitor(Rect{0, 0, 1920, 1080}, 1.0),
+			},
+			want: []Rect{{-1920, 0, 0, 1080}, {0, 0, 1920, 1080}},
+		},
+		{
+			name: "200% monitor above",
+			monitors: []Monitor{
+				layoutMonitor(Rect{0, 0, 1920, 1080}, 1.0),
+				layoutMonitor(Rect{0, -2160, 3840, 0}, 2.0),
+			},
+			want: []Rect{{0, 0, 1920, 1080}, {0, -1080, 1920, 0}},
+		},
+		{
+			name: "primary on 200% with 100% below and offset",
+			monitors: []Monitor{
+				layoutMonitor(Rect{0, 0, 3840, 2160}, 2.0),
+				layoutMonitor(Rect{1000, 2160, 2920, 3240}, 1.0),
+			},
+			want: []Rect{{0, 0, 1920, 1080}, {500, 1080, 2420, 2160}},
+		},
+		{
+			name: "vertical offset along shared edge",
+			monitors: []Monitor{
+				layoutMonitor(Rect{0, 0, 1920, 1080}, 1.0),
+				layoutMonitor(Rect{1920, 540, 5760, 2700}, 2.0),
+			},
+			want: []Rect{{0, 0, 1920, 1080}, {1920, 540, 3840, 1620}},
+		},
+		{
+			name: "chain keeps adjacency",
+			monitors: []Monitor{
+				layoutMonitor(Rect{5760, 0, 7680, 1080}, 1.0),
+				layoutMonitor(Rect{1920, 0, 5760, 2160}, 2.0),
+				layoutMonitor(Rect{0, 0, 1920, 1080}, 1.0),
+			},
+			want: []Rect{{3840, 0, 5760, 1080}, {1920, 0, 3840, 1080}, {0, 0, 1920, 1080}},
+		},
+		{
+			name: "disconnected monitor keeps screen origin",
+			monitors: []Monitor{
+				layoutMonitor(Rect{0, 0, 1920, 1080}, 1.0),
+				layoutMonitor(Rect{10000, 0, 11920, 1080}, 1.5),
+			},
+			want: []Rect{{0, 0, 1920, 1080}, {10000, 0, 11280, 720}},
+		},
+		{
+			name: "invalid monitors are skipped",
+			monitors: []Monitor{
+				layoutMonitor(Rect{0, 0, 1920, 1080}, 0),
+				layoutMonitor(Rect{1920, 0, 5760, 2160}, 2.0),
+			},
+			want: []Rect{{1920, 0, 3840, 1080}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout, err := NewLogicalLayout(tt.monitors)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []Rect
+			for _, lm := range layout.Monitors {
+				got = append(got, lm.Logical)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("no monitors", func(t *testing.T) {
+		if _, err := NewLogicalLayout(nil); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("got error %v, want %v", err, ErrNoMonitors)
+		}
+	})
+}
+
+func TestLogicalLayoutConversion(t *testing.T) {
+	monitors := []Monitor{
+		layoutMonitor(Rect{0, 0, 1920, 1080}, 1.0),
+		layoutMonitor(Rect{1920, 0, 5760, 2160}, 2.0),
+		layoutMonitor(Rect{-2560, 0, 0, 1440}, 1.25),
+	}
+	layout, err := NewLogicalLayout(monitors)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		screen  Rect
+		logical Rect
+	}{
+		{
+			name:    "100% monitor",
+			screen:  Rect{100, 100, 500, 400},
+			logical: Rect{100, 100, 500, 400},
+		},
+		{
+			name:    "200% monitor",
+			screen:  Rect{2120, 200, 2920, 800},
+			logical: Rect{2020, 100, 2420, 400},
+		},
+		{
+			name:    "125% monitor on the left",
+			screen:  Rect{-2560, 0, -1560, 500},
+			logical: Rect{-2048, 0, -1248, 400},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := layout.ScreenToGlobalLogicalRect(tt.screen)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.logical {
+				t.Errorf("ScreenToGlobalLogicalRect() = %v, want %v", got, tt.logical)
+			}
+
+			got, err = layout.GlobalLogicalToScreenRect(tt.logical)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.screen {
+				t.Errorf("GlobalLogicalToScreenRect() = %v, want %v", got, tt.screen)
+			}
+		})
+	}
+
+	t.Run("points", func(t *testing.T) {
+		p, err := layout.ScreenToGlobalLogicalPoint(3840, 1080)
+		if err != nil || p != (Point{2880, 540}) {
+			t.Errorf("ScreenToGlobalLogicalPoint() = %v, %v, want %v", p, err, Point{2880, 540})
+		}
+		p, err = layout.GlobalLogicalToScreenPoint(2880, 540)
+		if err != nil || p != (Point{3840, 1080}) {
+			t.Errorf("GlobalLogicalToScreenPoint() = %v, %v, want %v", p, err, Point{3840, 1080})
+		}
+	})
+
+	t.Run("empty layout", func(t *testing.T) {
+		if _, err := (LogicalLayout{}).ScreenToGlobalLogicalRect(Rect{0, 0, 10, 10}); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("got error %v, want %v", err, ErrNoMonitors)
+		}
+		if _, err := (LogicalLayout{}).GlobalLogicalToScreenRect(Rect{0, 0, 10, 10}); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("got error %v, want %v", err, ErrNoMonitors)
+		}
+	})
+}
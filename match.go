@@ -0,0 +1,94 @@
+package multimon
+
+// Scores used by MatchMonitor. Scores of all matching criteria are added up,
+// weighted so that a stronger criterion always outweighs all weaker ones combined.
+const (
+	// MatchScoreIdentity is awarded when manufacturer, model and serial
+	// number (the EDID identity) all match
+	MatchScoreIdentity = 1000
+	// MatchScoreModel is awarded when manufacturer and model match
+	MatchScoreModel = 200
+	// MatchScoreConnector is awarded when the connector or device name matches
+	MatchScoreConnector = 100
+	// MatchScoreResolution is awarded when the monitor has the same size in screen units
+	MatchScoreResolution = 40
+	// MatchScoreScale is awarded when the scale factor matches
+	MatchScoreScale = 20
+	// MatchScorePosition is awarded when the monitor is at the same position
+	MatchScorePosition = 10
+)
+
+// MatchMonitor finds the monitor that best corresponds to a saved monitor descriptor.
+// Monitors are scored by EDID identity, then model and connector, then resolution
+// and scale, then position (see the MatchScore constants).
+// A monitor is only considered a match if its identity, model, connector or
+// resolution matches; scale and position alone are used only to break ties.
+// Serial numbers are currently only reported on macOS, so MatchScoreIdentity
+// never applies on other platforms; connector names are not reported on macOS.
+// Invalid monitors are skipped.
+// Returns the best matching monitor and its score, or nil and 0 if nothing matches.
+func MatchMonitor(monitors []Monitor, d MonitorDescriptor) (*Monitor, int) {
+	var best *Monitor
+	bestScore := 0
+
+	for i := range monitors {
+		m := &monitors[i]
+		if validateMonitor(*m) != nil {
+			continue
+		}
+		score := scoreMonitorMatch(*m, d)
+		if score < MatchScoreResolution {
+			continue
+		}
+		if score > bestScore {
+			bestScore = score
+			best = m
+		}
+	}
+
+	return best, bestScore
+}
+
+// scoreMonitorMatch calculates how well a monitor matches a saved descriptor
+func scoreMonitorMatch(m Monitor, d MonitorDescriptor) int {
+	score := 0
+
+	if d.Manufacturer != "" && d.Model != "" &&
+		m.Manufacturer == d.Manufacturer && m.Model == d.Model {
+		score += MatchScoreModel
+		if d.Serial != "" && m.Serial == d.Serial {
+			score += MatchScoreIdentity
+		}
+	}
+	if d.Name != "" && m.Name == d.Name {
+		score += MatchScoreConnector
+	}
+
+	if validateRect(d.Bounds) == nil {
+		if isSameSize(m.Bounds, d.Bounds) {
+			score += MatchScoreResolution
+		}
+		if m.Bounds.Left == d.Bounds.Left && m.Bounds.Top == d.Bounds.Top {
+			score += MatchScorePosition
+		}
+	}
+	if d.Scale > 0.0 && m.Scale == d.Scale {
+		score += MatchScoreScale
+	}
+
+	return score
+}
+
+// TranslateToMonitor moves a window rect saved relative to a monitor descriptor
+// so that it keeps the same offset from the origin of monitor m.
+// Window size is not changed.
+func TranslateToMonitor(d MonitorDescriptor, m Monitor, window Rect) Rect {
+	dx := m.Bounds.Left - d.Bounds.Left
+	dy := m.Bounds.Top - d.Bounds.Top
+	return Rect{
+		Left:   window.Left + dx,
+		Top:    window.Top + dy,
+		Right:  window.Right + dx,
+		Bottom: window.Bottom + dy,
+	}
+}
@@ -0,0 +1,188 @@
+package multimon
+
+import "testing"
+
+func TestMatchMonitor(t *testing.T) {
+	dell := Monitor{
+		Bounds:       Rect{0, 0, 2560, 1440},
+		WorkArea:     Rect{0, 0, 2560, 1400},
+		Scale:        1.0,
+		Name:         "DP-1",
+		Manufacturer: "DEL",
+		Model:        "A0B1",
+		Serial:       "12345",
+	}
+	dellTwin := Monitor{
+		Bounds:       Rect{2560, 0, 5120, 1440},
+		WorkArea:     Rect{2560, 0, 5120, 1440},
+		Scale:        1.0,
+		Name:         "DP-2",
+		Manufacturer: "DEL",
+		Model:        "A0B1",
+		Serial:       "67890",
+	}
+	laptop := Monitor{
+		Bounds:   Rect{-1920, 0, 0, 1200},
+		WorkArea: Rect{-1920, 0, 0, 1160},
+		Scale:    1.5,
+		Name:     "eDP-1",
+	}
+
+	tests := []struct {
+		name      string
+		monitors  []Monitor
+		desc      MonitorDescriptor
+		wantIndex int // -1 for no match
+	}{
+		{
+			name:      "edid identity survives connector rename and move",
+			monitors:  []Monitor{laptop, dellTwin, withName(withOrigin(dell, 0, 0), "DP-3")},
+			desc:      DescribeMonitor(withOrigin(dell, 5000, 0)),
+			wantIndex: 2,
+		},
+		{
+			name:      "identity beats connector",
+			monitors:  []Monitor{withName(dellTwin, "DP-1"), withName(dell, "DP-2")},
+			desc:      DescribeMonitor(dell),
+			wantIndex: 1,
+		},
+		{
+			name:      "model match without serial prefers same connector",
+			monitors:  []Monitor{withSerial(dell, ""), withSerial(dellTwin, "")},
+			desc:      DescribeMonitor(withSerial(dellTwin, "")),
+			wantIndex: 1,
+		},
+		{
+			name:      "connector only",
+			monitors:  []Monitor{dell, laptop},
+			desc:      MonitorDescriptor{Name: "eDP-1"},
+			wantIndex: 1,
+		},
+		{
+			name:     "resolution and scale",
+			monitors: []Monitor{laptop, dell},
+			desc: MonitorDescriptor{
+				Bounds: Rect{100, 100, 2660, 1540},
+				Scale:  1.0,
+			},
+			wantIndex: 1,
+		},
+		{
+			name:     "resolution match prefers same position",
+			monitors: []Monitor{{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1080}, Scale: 1.0}, {Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 1.0}},
+			desc: MonitorDescriptor{
+				Bounds: Rect{1920, 0, 3840, 1080},
+				Scale:  1.0,
+			},
+			wantIndex: 1,
+		},
+		{
+			name:     "position and scale alone do not match",
+			monitors: []Monitor{dell},
+			desc: MonitorDescriptor{
+				Bounds: Rect{0, 0, 1920, 1080},
+				Scale:  1.0,
+			},
+			wantIndex: -1,
+		},
+		{
+			name:      "empty descriptor",
+			monitors:  []Monitor{dell, laptop},
+			desc:      MonitorDescriptor{},
+			wantIndex: -1,
+		},
+		{
+			name: "invalid monitor is skipped",
+			monitors: []Monitor{{
+				Bounds: Rect{0, 0, 2560, 1440},
+				Scale:  1.0,
+			}},
+			desc:      DescribeMonitor(dell),
+			wantIndex: -1,
+		},
+		{
+			name:      "no monitors",
+			monitors:  nil,
+			desc:      DescribeMonitor(dell),
+			wantIndex: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, score := MatchMonitor(tt.monitors, tt.desc)
+			if tt.wantIndex < 0 {
+				if got != nil {
+					t.Errorf("expected no match, got %v with score %d", got, score)
+				}
+				return
+			}
+			if got != &tt.monitors[tt.wantIndex] {
+				t.Errorf("got %v with score %d, want monitor %d", got, score, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestTranslateToMonitor(t *testing.T) {
+	d := MonitorDescriptor{Bounds: Rect{1920, 0, 3840, 1080}}
+	m := Monitor{Bounds: Rect{-1920, 200, 0, 1280}}
+
+	got := TranslateToMonitor(d, m, Rect{2020, 100, 2820, 700})
+	want := Rect{-1820, 300, -1020, 900}
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestWindowStateRestoreMatchedMonitor(t *testing.T) {
+	external := Monitor{
+		Bounds:       Rect{1920, 0, 4480, 1440},
+		WorkArea:     Rect{1920, 0, 4480, 1400},
+		Scale:        1.0,
+		Name:         "DP-1",
+		Manufacturer: "GSM",
+		Model:        "5B08",
+		Serial:       "1111",
+	}
+	laptop := Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 0, 1920, 1040},
+		Scale:    1.0,
+	}
+
+	s := CaptureWindowState([]Monitor{laptop, external}, Rect{2120, 100, 2920, 700}, false, false)
+
+	// The external monitor was moved to the left of the laptop and renamed
+	moved := withName(withOrigin(external, -2560, 0), "DP-3")
+	monitors := []Monitor{laptop, moved}
+
+	p, err := s.Restore(monitors)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Rect{-2360, 100, -1560, 700}
+	if p.Rect != want {
+		t.Errorf("got rect %v, want %v", p.Rect, want)
+	}
+	if p.Monitor != &monitors[1] {
+		t.Errorf("got monitor %v, want moved external monitor", p.Monitor)
+	}
+}
+
+func withOrigin(m Monitor, x, y int) Monitor {
+	dx, dy := x-m.Bounds.Left, y-m.Bounds.Top
+	m.Bounds = Rect{m.Bounds.Left + dx, m.Bounds.Top + dy, m.Bounds.Right + dx, m.Bounds.Bottom + dy}
+	m.WorkArea = Rect{m.WorkArea.Left + dx, m.WorkArea.Top + dy, m.WorkArea.Right + dx, m.WorkArea.Bottom + dy}
+	return m
+}
+
+func withName(m Monitor, name string) Monitor {
+	m.Name = name
+	return m
+}
+
+func withSerial(m Monitor, serial string) Monitor {
+	m.Serial = serial
+	return m
+}
@@ -0,0 +1,148 @@
+package multimon
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrNoWindowState is returned when a PlacementMemory has no recorded states
+var ErrNoWindowState = errors.New("no window state recorded")
+
+// MemoryMatch describes how a state was selected from a PlacementMemory
+type MemoryMatch int
+
+const (
+	// MemoryMatchNone means no state was found
+	MemoryMatchNone MemoryMatch = iota
+	// MemoryMatchGeneric means no configuration matched and the most recently
+	// recorded state is used, relying on FitToNearestMonitor to make it visible
+	MemoryMatchGeneric
+	// MemoryMatchCompatible means the state was recorded in a different
+	// configuration, but the monitor that held the window is still present
+	// with the same bounds and scale
+	MemoryMatchCompatible
+	// MemoryMatchExact means the state was recorded in the current configuration
+	MemoryMatchExact
+)
+
+// PlacementMemory remembers the last window state for each monitor
+// configuration, keyed by LayoutFingerprint. This allows a window to return
+// to its previous position when a configuration reappears, for example when
+// a laptop is docked again. PlacementMemory can be stored as JSON.
+type PlacementMemory struct {
+	States map[string]WindowState `json:"states,omitempty"` // States keyed by layout fingerprint
+	Order  []string               `json:"order,omitempty"`  // Fingerprints from least to most recently recorded
+}
+
+// Record stores the state for the configuration described by monitors,
+// replacing any state previously recorded for that configuration.
+// The state's Layout field is set to the configuration's fingerprint.
+func (pm *PlacementMemory) Record(monitors []Monitor, s WindowState) {
+	key := LayoutFingerprint(monitors)
+	s.Layout = key
+	if pm.States == nil {
+		pm.States = make(map[string]WindowState)
+	}
+	pm.States[key] = s
+
+	order := pm.Order[:0]
+	for _, k := range pm.Order {
+		if k != key {
+			order = append(order, k)
+		}
+	}
+	pm.Order = append(order, key)
+}
+
+// Lookup selects the recorded state best suited for the given monitors:
+// 1. The state recorded in the same configuration
+// 2. A state whose monitor is still present with the same bounds and scale,
+// preferring the most recently recorded one
+// 3. The most recently recorded state
+//
+// Returns the state, how it was matched, and false if nothing is recorded.
+func (pm *PlacementMemory) Lookup(monitors []Monitor) (WindowState, MemoryMatch, bool) {
+	if len(pm.States) == 0 {
+		return WindowState{}, MemoryMatchNone, false
+	}
+
+	if s, ok := pm.States[LayoutFingerprint(monitors)]; ok {
+		return s, MemoryMatchExact, true
+	}
+
+	keys := pm.recencyOrder()
+	for _, k := range keys {
+		if s := pm.States[k]; isStateCompatible(s, monitors) {
+			return s, MemoryMatchCompatible, true
+		}
+	}
+
+	return pm.States[keys[0]], MemoryMatchGeneric, true
+}
+
+// Restore selects a state with Lookup and restores it against the given monitors.
+// Exact and compatible states are restored with WindowState.Restore; a generic
+// state is fitted with FitToNearestMonitor.
+// Returns ErrNoWindowState if nothing is recorded.
+func (pm *PlacementMemory) Restore(monitors []Monitor) (Placement, error) {
+	s, match, ok := pm.Lookup(monitors)
+	if !ok {
+		return Placement{}, ErrNoWindowState
+	}
+	if match != MemoryMatchGeneric {
+		return s.Restore(monitors)
+	}
+	if err := s.checkVersion(); err != nil {
+		return Placement{}, err
+	}
+	return s.restoreNearest(monitors)
+}
+
+// Last returns the fingerprint of the most recently recorded state,
+// or an empty string if nothing is recorded
+func (pm *PlacementMemory) Last() string {
+	if keys := pm.recencyOrder(); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// recencyOrder returns the fingerprints of the recorded states from most to
+// least recently recorded. States missing from Order (e.g. after a hand edit)
+// come last, in key order so that the result does not depend on map order.
+func (pm *PlacementMemory) recencyOrder() []string {
+	keys := make([]string, 0, len(pm.States))
+	seen := make(map[string]bool, len(pm.States))
+	add := func(k string) {
+		if _, ok := pm.States[k]; ok && !seen[k] {
+			keys = append(keys, k)
+			seen[k] = true
+		}
+	}
+
+	for i := len(pm.Order) - 1; i >= 0; i-- {
+		add(pm.Order[i])
+	}
+	rest := make([]string, 0, len(pm.States))
+	for k := range pm.States {
+		if !seen[k] {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+	for _, k := range rest {
+		add(k)
+	}
+	return keys
+}
+
+// isStateCompatible checks if the monitor that held the window when the
+// state was saved is present among monitors with the same bounds and scale
+func isStateCompatible(s WindowState, monitors []Monitor) bool {
+	for _, m := range monitors {
+		if m.Bounds == s.Monitor.Bounds && m.Scale == s.Monitor.Scale {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+package multimon
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPlacementMemory(t *testing.T) {
+	laptop := Monitor{
+		Bounds:   Rect{0, 0, 1920, 1200},
+		WorkArea: Rect{0, 0, 1920, 1160},
+		Scale:    1.5,
+	}
+	external := Monitor{
+		Bounds:   Rect{1920, 0, 4480, 1440},
+		WorkArea: Rect{1920, 0, 4480, 1400},
+		Scale:    1.0,
+	}
+	docked := []Monitor{laptop, external}
+	undocked := []Monitor{laptop}
+
+	onExternal := CaptureWindowState(docked, Rect{3000, 200, 4000, 900}, false, false)
+	onLaptop := CaptureWindowState(undocked, Rect{100, 100, 900, 700}, true, false)
+
+	t.Run("empty memory", func(t *testing.T) {
+		var pm PlacementMemory
+		if _, match, ok := pm.Lookup(docked); ok || match != MemoryMatchNone {
+			t.Errorf("got match=%v ok=%v, want none", match, ok)
+		}
+		if _, err := pm.Restore(docked); !errors.Is(err, ErrNoWindowState) {
+			t.Errorf("got error %v, want %v", err, ErrNoWindowState)
+		}
+	})
+
+	t.Run("exact match after re-docking", func(t *testing.T) {
+		var pm PlacementMemory
+		pm.Record(docked, onExternal)
+		pm.Record(undocked, onLaptop)
+
+		s, match, ok := pm.Lookup(docked)
+		if !ok || match != MemoryMatchExact {
+			t.Fatalf("got match=%v ok=%v, want exact", match, ok)
+		}
+		if s.Normal != onExternal.Normal {
+			t.Errorf("got normal %v, want %v", s.Normal, onExternal.Normal)
+		}
+
+		p, err := pm.Restore(docked)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if p.Rect != onExternal.Normal {
+			t.Errorf("got rect %v, want %v", p.Rect, onExternal.Normal)
+		}
+		if p.Monitor != &docked[1] {
+			t.Errorf("expected window on external monitor, got %v", p.Monitor)
+		}
+	})
+
+	t.Run("compatible match", func(t *testing.T) {
+		var pm PlacementMemory
+		pm.Record(docked, onExternal)
+
+		// A third monitor is added, but the external monitor is unchanged
+		extra := Monitor{
+			Bounds:   Rect{-1920, 0, 0, 1080},
+			WorkArea: Rect{-1920, 0, 0, 1080},
+			Scale:    1.0,
+		}
+		current := []Monitor{laptop, external, extra}
+
+		_, match, ok := pm.Lookup(current)
+		if !ok || match != MemoryMatchCompatible {
+			t.Fatalf("got match=%v ok=%v, want compatible", match, ok)
+		}
+		p, err := pm.Restore(current)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if p.Rect != onExternal.Normal {
+			t.Errorf("got rect %v, want %v", p.Rect, onExternal.Normal)
+		}
+	})
+
+	t.Run("exact match spanning two monitors", func(t *testing.T) {
+		var pm PlacementMemory
+		spanning := CaptureWindowState(docked, Rect{1800, 100, 2600, 700}, false, false)
+		pm.Record(docked, spanning)
+
+		p, err := pm.Restore(docked)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if p.Rect != spanning.Normal {
+			t.Errorf("got rect %v, want %v", p.Rect, spanning.Normal)
+		}
+	})
+
+	t.Run("compatible match prefers most recent", func(t *testing.T) {
+		left := Monitor{
+			Bounds:   Rect{-1920, 0, 0, 1080},
+			WorkArea: Rect{-1920, 0, 0, 1080},
+			Scale:    1.0,
+		}
+		above := Monitor{
+			Bounds:   Rect{1920, -1080, 3840, 0},
+			WorkArea: Rect{1920, -1080, 3840, 0},
+			Scale:    1.0,
+		}
+		first := CaptureWindowState(docked, Rect{2000, 100, 2800, 700}, false, false)
+		second := CaptureWindowState([]Monitor{external, left}, Rect{3000, 300, 3800, 900}, false, false)
+		current := []Monitor{external, above}
+
+		for _, order := range [][]WindowState{{first, second}, {second, first}} {
+			var pm PlacementMemory
+			pm.Record(docked, order[0])
+			pm.Record([]Monitor{external, left}, order[1])
+			// The most recent state is not compatible
+			pm.Record(undocked, onLaptop)
+
+			s, match, ok := pm.Lookup(current)
+			if !ok || match != MemoryMatchCompatible {
+				t.Fatalf("got match=%v ok=%v, want compatible", match, ok)
+			}
+			if s.Normal != order[1].Normal {
+				t.Errorf("got normal %v, want %v", s.Normal, order[1].Normal)
+			}
+		}
+	})
+
+	t.Run("generic fallback", func(t *testing.T) {
+		var pm PlacementMemory
+		pm.Record(docked, onExternal)
+
+		_, match, ok := pm.Lookup(undocked)
+		if !ok || match != MemoryMatchGeneric {
+			t.Fatalf("got match=%v ok=%v, want generic", match, ok)
+		}
+		p, err := pm.Restore(undocked)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Rescaled from 1.0 to 1.5 and fitted to the laptop work area
+		want := Rect{420, 110, 1920, 1160}
+		if p.Rect != want {
+			t.Errorf("got rect %v, want %v", p.Rect, want)
+		}
+	})
+
+	t.Run("last is the most recent record", func(t *testing.T) {
+		var pm PlacementMemory
+		if pm.Last() != "" {
+			t.Errorf("got last %q for empty memory, want empty", pm.Last())
+		}
+		pm.Record(docked, onExternal)
+		pm.Record(undocked, onLaptop)
+		pm.Record(docked, onExternal)
+		if want := LayoutFingerprint(docked); pm.Last() != want {
+			t.Errorf("got last %q, want %q", pm.Last(), want)
+		}
+	})
+
+	t.Run("record replaces state", func(t *testing.T) {
+		var pm PlacementMemory
+		pm.Record(undocked, onLaptop)
+		moved := onLaptop
+		moved.Normal = Rect{200, 200, 1000, 800}
+		pm.Record(undocked, moved)
+
+		if len(pm.States) != 1 || len(pm.Order) != 1 {
+			t.Errorf("got %d states and %d order entries, want 1", len(pm.States), len(pm.Order))
+		}
+		s, _, _ := pm.Lookup(undocked)
+		if s.Normal != moved.Normal {
+			t.Errorf("got normal %v, want %v", s.Normal, moved.Normal)
+		}
+	})
+
+	t.Run("json round trip", func(t *testing.T) {
+		var pm PlacementMemory
+		pm.Record(docked, onExternal)
+		pm.Record(undocked, onLaptop)
+
+		data, err := json.Marshal(&pm)
+		if err != nil {
+			t.Fatalf("marshal failed: %v", err)
+		}
+		var got PlacementMemory
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("unmarshal failed: %v", err)
+		}
+		if got.Last() != pm.Last() || len(got.States) != len(pm.States) || !reflect.DeepEqual(got.Order, pm.Order) {
+			t.Errorf("round trip got %+v, want %+v", got, pm)
+		}
+		for k, v := range pm.States {
+			if got.States[k] != v {
+				t.Errorf("state %q: got %+v, want %+v", k, got.States[k], v)
+			}
+		}
+	})
+}
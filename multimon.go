@@ -15,3 +15,10 @@ type Rect = types.Rect
 func GetMonitors() []Monitor {
 	return platform.GetPlatformMonitors()
 }
+
+// GetPointerPosition returns the mouse pointer position in screen units.
+// Returns false if the platform does not provide the pointer position.
+func GetPointerPosition() (Point, bool) {
+	x, y, ok := platform.GetPlatformPointer()
+	return Point{X: x, Y: y}, ok
+}
@@ -20,8 +20,17 @@ typedef struct monitorInfo {
     int workY;
     int workWidth;
     int workHeight;
+    unsigned int vendor;
+    unsigned int model;
+    unsigned int serial;
+    int widthMM;
+    int heightMM;
 } monitorInfo;
 
+NSPoint GetPointerLocation() {
+    return [NSEvent mouseLocation];
+}
+
 int GetNumMonitors() {
     return [[NSScreen screens] count];
 }
@@ -45,11 +54,25 @@ monitorInfo GetMonitorInfo(int nth) {
     result.workWidth = (int)visibleFrame.size.width;
     result.workHeight = (int)visibleFrame.size.height;
 
+    // Get EDID identity from the CoreGraphics display
+    NSNumber *displayID = [[screen deviceDescription] objectForKey:@"NSScreenNumber"];
+    CGDirectDisplayID did = [displayID unsignedIntValue];
+    result.vendor = CGDisplayVendorNumber(did);
+    result.model = CGDisplayModelNumber(did);
+    result.serial = CGDisplaySerialNumber(did);
+
+    // Physical size in millimeters, zero if not available
+    CGSize size = CGDisplayScreenSize(did);
+    result.widthMM = (int)size.width;
+    result.heightMM = (int)size.height;
+
     return result;
 }
 */
 import "C"
 import (
+	"fmt"
+
 	"github.com/adnsv/multimon/types"
 )
 
@@ -72,7 +95,9 @@ func GetPlatformMonitors() []types.Monitor {
 		y := mainHeight - (int(info.y) + int(info.height))
 		workY := mainHeight - (int(info.workY) + int(info.workHeight))
 
-		// Create monitor with screen coordinates in points (macOS native units)
+		// Create monitor with screen coordinates in points (macOS native units).
+		// Name is left empty: macOS only provides the product name, which is
+		// not a connector and is shared by all monitors of the same model.
 		m := types.Monitor{
 			Bounds: types.Rect{
 				Left:   int(info.x),
@@ -86,7 +111,16 @@ func GetPlatformMonitors() []types.Monitor {
 				Right:  int(info.workX + info.workWidth),
 				Bottom: workY + int(info.workHeight),
 			},
-			Scale: 1.0, // Always 1.0 since we work with screen points
+			Scale:    1.0, // Always 1.0 since we work with screen points
+			WidthMM:  int(info.widthMM),
+			HeightMM: int(info.heightMM),
+		}
+		if info.vendor != 0 {
+			m.Manufacturer = fmt.Sprintf("%04X", uint(info.vendor))
+			m.Model = fmt.Sprintf("%04X", uint(info.model))
+		}
+		if info.serial != 0 {
+			m.Serial = fmt.Sprintf("%d", uint(info.serial))
 		}
 
 		monitors = append(monitors, m)
@@ -94,3 +128,15 @@ func GetPlatformMonitors() []types.Monitor {
 
 	return monitors
 }
+
+// GetPlatformPointer returns the mouse pointer position in screen coordinates
+func GetPlatformPointer() (x, y int, ok bool) {
+	if int(C.GetNumMonitors()) == 0 {
+		return 0, 0, false
+	}
+
+	// Convert Y coordinate from bottom-left origin using main screen height
+	mainScreen := C.GetMonitorInfo(0)
+	loc := C.GetPointerLocation()
+	return int(loc.x), int(mainScreen.height) - int(loc.y), true
+}
@@ -44,9 +44,43 @@ Monitor GetMonitorInfo(GdkMonitor *monitor) {
 
     return result;
 }
+
+// GetPointerPosition retrieves the pointer position of the default seat.
+// Returns 0 if the position is not available.
+int GetPointerPosition(int *x, int *y) {
+    GdkDisplay *display = gdk_display_get_default();
+    if (display == NULL) {
+        return 0;
+    }
+    GdkSeat *seat = gdk_display_get_default_seat(display);
+    if (seat == NULL) {
+        return 0;
+    }
+    GdkDevice *pointer = gdk_seat_get_pointer(seat);
+    if (pointer == NULL) {
+        return 0;
+    }
+    gdk_device_get_position(pointer, NULL, x, y);
+    return 1;
+}
+
+// GetMonitorPlugName returns a newly allocated connector name or NULL.
+// The result must be released with g_free.
+char *GetMonitorPlugName(int index) {
+    GdkScreen *screen = gdk_screen_get_default();
+    if (screen == NULL) {
+        return NULL;
+    }
+    G_GNUC_BEGIN_IGNORE_DEPRECATIONS
+    char *name = gdk_screen_get_monitor_plug_name(screen, index);
+    G_GNUC_END_IGNORE_DEPRECATIONS
+    return name;
+}
 */
 import "C"
 import (
+	"unsafe"
+
 	"github.com/adnsv/multimon/types"
 )
 
@@ -92,7 +126,17 @@ func GetPlatformMonitors() []types.Monitor {
 				Right:  int(info.workX + info.workWidth),
 				Bottom: int(info.workY + info.workHeight),
 			},
-			Scale: scale,
+			Scale:        scale,
+			Manufacturer: C.GoString(C.gdk_monitor_get_manufacturer(monitor)),
+			Model:        C.GoString(C.gdk_monitor_get_model(monitor)),
+			WidthMM:      int(C.gdk_monitor_get_width_mm(monitor)),
+			HeightMM:     int(C.gdk_monitor_get_height_mm(monitor)),
+		}
+
+		// Connector name (e.g. "DP-1") is only available through GdkScreen in GTK3
+		if name := C.GetMonitorPlugName(C.int(i)); name != nil {
+			m.Name = C.GoString(name)
+			C.g_free(C.gpointer(unsafe.Pointer(name)))
 		}
 
 		monitors = append(monitors, m)
@@ -100,3 +144,12 @@ func GetPlatformMonitors() []types.Monitor {
 
 	return monitors
 }
+
+// GetPlatformPointer returns the mouse pointer position in screen coordinates
+func GetPlatformPointer() (x, y int, ok bool) {
+	var cx, cy C.int
+	if C.GetPointerPosition(&cx, &cy) == 0 {
+		return 0, 0, false
+	}
+	return int(cx), int(cy), true
+}
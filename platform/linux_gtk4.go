@@ -107,7 +107,12 @@ func GetPlatformMonitors() []types.Monitor {
 				Right:  int(info.workX + info.workWidth),
 				Bottom: int(info.workY + info.workHeight),
 			},
-			Scale: scale,
+			Scale:        scale,
+			Name:         C.GoString(C.gdk_monitor_get_connector(monitor)),
+			Manufacturer: C.GoString(C.gdk_monitor_get_manufacturer(monitor)),
+			Model:        C.GoString(C.gdk_monitor_get_model(monitor)),
+			WidthMM:      int(C.gdk_monitor_get_width_mm(monitor)),
+			HeightMM:     int(C.gdk_monitor_get_height_mm(monitor)),
 		}
 
 		monitors = append(monitors, m)
@@ -116,3 +121,10 @@ func GetPlatformMonitors() []types.Monitor {
 
 	return monitors
 }
+
+// GetPlatformPointer returns the mouse pointer position in screen coordinates.
+// GTK4 does not expose the global pointer position (it is not available on
+// Wayland), so this always reports that the position is unknown.
+func GetPlatformPointer() (x, y int, ok bool) {
+	return 0, 0, false
+}
@@ -4,6 +4,7 @@
 package platform
 
 import (
+	"strings"
 	"syscall"
 	"unsafe"
 
@@ -12,13 +13,18 @@ import (
 
 var (
 	user32 = syscall.NewLazyDLL("user32.dll")
+	gdi32  = syscall.NewLazyDLL("gdi32.dll")
 	shcore = syscall.NewLazyDLL("shcore.dll")
 
 	procEnumDisplayMonitors    = user32.NewProc("EnumDisplayMonitors")
+	procEnumDisplayDevices     = user32.NewProc("EnumDisplayDevicesW")
+	procGetCursorPos           = user32.NewProc("GetCursorPos")
 	procGetMonitorInfo         = user32.NewProc("GetMonitorInfoW")
 	procGetDpiForMonitor       = shcore.NewProc("GetDpiForMonitor")
+	procCreateDC               = gdi32.NewProc("CreateDCW")
+	procDeleteDC               = gdi32.NewProc("DeleteDC")
 	procGetDC                  = user32.NewProc("GetDC")
-	procGetDeviceCaps          = user32.NewProc("GetDeviceCaps")
+	procGetDeviceCaps          = gdi32.NewProc("GetDeviceCaps")
 	procReleaseDC              = user32.NewProc("ReleaseDC")
 	procSetProcessDPIAware     = user32.NewProc("SetProcessDPIAware")
 	procSetProcessDpiAwareness = shcore.NewProc("SetProcessDpiAwareness")
@@ -43,6 +49,10 @@ type RECT struct {
 	Left, Top, Right, Bottom int32
 }
 
+type POINT struct {
+	X, Y int32
+}
+
 type MONITORINFO struct {
 	CbSize    uint32
 	RcMonitor RECT
@@ -50,6 +60,20 @@ type MONITORINFO struct {
 	DwFlags   uint32
 }
 
+type MONITORINFOEX struct {
+	MONITORINFO
+	SzDevice [32]uint16
+}
+
+type DISPLAY_DEVICE struct {
+	Cb           uint32
+	DeviceName   [32]uint16
+	DeviceString [128]uint16
+	StateFlags   uint32
+	DeviceID     [128]uint16
+	DeviceKey    [128]uint16
+}
+
 const (
 	MONITORINFOF_PRIMARY = 0x1
 	MDT_EFFECTIVE_DPI    = 0
@@ -59,7 +83,7 @@ const (
 func GetPlatformMonitors() []types.Monitor {
 	var monitors []types.Monitor
 	callback := func(hMonitor HMONITOR, hdcMonitor HDC, lprcMonitor *RECT, dwData uintptr) uintptr {
-		var mi MONITORINFO
+		var mi MONITORINFOEX
 		mi.CbSize = uint32(unsafe.Sizeof(mi))
 
 		ret, _, _ := procGetMonitorInfo.Call(
@@ -114,7 +138,10 @@ func GetPlatformMonitors() []types.Monitor {
 				Bottom: int(mi.RcWork.Bottom),
 			},
 			Scale: scale,
+			Name:  syscall.UTF16ToString(mi.SzDevice[:]),
 		}
+		monitor.Manufacturer, monitor.Model = getMonitorHardwareID(&mi.SzDevice[0])
+		monitor.WidthMM, monitor.HeightMM = getMonitorPhysicalSize(&mi.SzDevice[0])
 
 		monitors = append(monitors, monitor)
 		return 1
@@ -129,3 +156,51 @@ func GetPlatformMonitors() []types.Monitor {
 
 	return monitors
 }
+
+// getMonitorHardwareID returns the EDID manufacturer ID and product code of
+// the first monitor attached to the given display device. They are parsed
+// from the device ID which has the form "MONITOR\GSM5B08\{...}\0001".
+func getMonitorHardwareID(deviceName *uint16) (manufacturer, model string) {
+	var dd DISPLAY_DEVICE
+	dd.Cb = uint32(unsafe.Sizeof(dd))
+
+	ret, _, _ := procEnumDisplayDevices.Call(
+		uintptr(unsafe.Pointer(deviceName)),
+		0,
+		uintptr(unsafe.Pointer(&dd)),
+		0,
+	)
+	if ret == 0 {
+		return "", ""
+	}
+
+	parts := strings.Split(syscall.UTF16ToString(dd.DeviceID[:]), `\`)
+	if len(parts) < 2 || len(parts[1]) < 4 {
+		return "", ""
+	}
+	return parts[1][:3], parts[1][3:]
+}
+
+// GetPlatformPointer returns the mouse pointer position in screen coordinates
+func GetPlatformPointer() (x, y int, ok bool) {
+	var pt POINT
+	ret, _, _ := procGetCursorPos.Call(uintptr(unsafe.Pointer(&pt)))
+	if ret == 0 {
+		return 0, 0, false
+	}
+	return int(pt.X), int(pt.Y), true
+}
+
+// getMonitorPhysicalSize returns the physical size of the display device
+// in millimeters, or zeros if it is not available
+func getMonitorPhysicalSize(deviceName *uint16) (widthMM, heightMM int) {
+	dc, _, _ := procCreateDC.Call(0, uintptr(unsafe.Pointer(deviceName)), 0, 0)
+	if dc == 0 {
+		return 0, 0
+	}
+	defer procDeleteDC.Call(dc)
+
+	w, _, _ := procGetDeviceCaps.Call(dc, 4) // HORZSIZE = 4
+	h, _, _ := procGetDeviceCaps.Call(dc, 6) // VERTSIZE = 6
+	return int(int32(w)), int(int32(h))
+}
@@ -0,0 +1,160 @@
+package multimon
+
+import "fmt"
+
+// ConstraintAdjustment specifies how a Positioner may adjust a popup that
+// does not fit the work area. Values can be combined.
+type ConstraintAdjustment uint
+
+const (
+	// AdjustSlideX moves the popup horizontally until it fits
+	AdjustSlideX ConstraintAdjustment = 1 << iota
+	// AdjustSlideY moves the popup vertically until it fits
+	AdjustSlideY
+	// AdjustFlipX mirrors anchor, gravity and offset horizontally
+	AdjustFlipX
+	// AdjustFlipY mirrors anchor, gravity and offset vertically
+	AdjustFlipY
+	// AdjustResizeX shrinks the popup horizontally to the work area
+	AdjustResizeX
+	// AdjustResizeY shrinks the popup vertically to the work area
+	AdjustResizeY
+
+	// AdjustNone does not adjust a constrained popup
+	AdjustNone ConstraintAdjustment = 0
+)
+
+// Positioner computes the position of a popup (menu, tooltip, dropdown) relative
+// to an anchor rect, following the semantics of the Wayland xdg_positioner:
+// 1. The anchor point is the point of AnchorRect selected by Anchor
+// (GravityCenter is the center of the rect, GravityNorthWest its top-left corner, etc.)
+// 2. The popup extends from the anchor point in the direction of Gravity:
+// GravitySouthEast places the popup's top-left corner at the anchor point,
+// GravityCenter centers the popup on the anchor point
+// 3. Offset is added to the popup position
+// 4. If the popup does not fit the work area of the monitor holding the anchor rect,
+// it is adjusted on each axis in this order: flip, slide, resize
+//
+// All coordinates are in screen units.
+type Positioner struct {
+	Size                 Size                 // Popup size
+	AnchorRect           Rect                 // Rect the popup is anchored to, may be empty
+	Anchor               Gravity              // Point of AnchorRect the popup is anchored to
+	Gravity              Gravity              // Direction the popup extends from the anchor point
+	Offset               Point                // Offset from the anchor point
+	ConstraintAdjustment ConstraintAdjustment // Allowed adjustments
+}
+
+// Position computes the popup rect in screen units.
+// Flipping is only applied if the flipped popup fits the work area on that axis.
+// Sliding keeps the left (top) edge visible if the popup is larger than the work area.
+// Resizing is only applied if the resulting size is positive.
+// Returns error if popup size is invalid or no valid monitors are available.
+func (p Positioner) Position(monitors []Monitor) (Rect, error) {
+	if p.Size.Width <= 0 || p.Size.Height <= 0 {
+		return Rect{}, fmt.Errorf("invalid popup size: %w: width=%d, height=%d",
+			ErrInvalidDimensions, p.Size.Width, p.Size.Height)
+	}
+
+	// An empty anchor rect is treated as a point
+	anchor := p.AnchorRect
+	if anchor.Right <= anchor.Left {
+		anchor.Right = anchor.Left + 1
+	}
+	if anchor.Bottom <= anchor.Top {
+		anchor.Bottom = anchor.Top + 1
+	}
+	m := findValidMonitor(monitors, anchor)
+	if m == nil {
+		return Rect{}, ErrNoMonitors
+	}
+	bounds := m.WorkArea
+
+	left, right := positionAxis(
+		p.AnchorRect.Left, p.AnchorRect.Right, p.Size.Width,
+		p.Anchor.horizontal(), p.Gravity.horizontal(), p.Offset.X,
+		bounds.Left, bounds.Right,
+		p.ConstraintAdjustment&AdjustFlipX != 0,
+		p.ConstraintAdjustment&AdjustSlideX != 0,
+		p.ConstraintAdjustment&AdjustResizeX != 0)
+	top, bottom := positionAxis(
+		p.AnchorRect.Top, p.AnchorRect.Bottom, p.Size.Height,
+		p.Anchor.vertical(), p.Gravity.vertical(), p.Offset.Y,
+		bounds.Top, bounds.Bottom,
+		p.ConstraintAdjustment&AdjustFlipY != 0,
+		p.ConstraintAdjustment&AdjustSlideY != 0,
+		p.ConstraintAdjustment&AdjustResizeY != 0)
+
+	return Rect{
+		Left:   left,
+		Top:    top,
+		Right:  right,
+		Bottom: bottom,
+	}, nil
+}
+
+// positionAxis positions a popup along one axis and applies constraint adjustments.
+// anchor and gravity are -1, 0 or 1 (see Gravity.horizontal and Gravity.vertical).
+func positionAxis(anchorMin, anchorMax, size, anchor, gravity, offset, boundsMin, boundsMax int, flip, slide, resize bool) (int, int) {
+	lo := placeAxis(anchorMin, anchorMax, size, anchor, gravity, offset)
+	hi := lo + size
+	isConstrained := func(lo, hi int) bool {
+		return lo < boundsMin || hi > boundsMax
+	}
+
+	if !isConstrained(lo, hi) {
+		return lo, hi
+	}
+
+	if flip {
+		flippedLo := placeAxis(anchorMin, anchorMax, size, -anchor, -gravity, -offset)
+		if !isConstrained(flippedLo, flippedLo+size) {
+			return flippedLo, flippedLo + size
+		}
+	}
+
+	if slide {
+		if hi > boundsMax {
+			lo -= hi - boundsMax
+			hi = boundsMax
+		}
+		if lo < boundsMin {
+			hi += boundsMin - lo
+			lo = boundsMin
+		}
+		if !isConstrained(lo, hi) {
+			return lo, hi
+		}
+	}
+
+	if resize {
+		newLo := max(lo, boundsMin)
+		newHi := min(hi, boundsMax)
+		if newHi > newLo {
+			return newLo, newHi
+		}
+	}
+
+	return lo, hi
+}
+
+// placeAxis calculates the unconstrained popup position along one axis
+func placeAxis(anchorMin, anchorMax, size, anchor, gravity, offset int) int {
+	var point int
+	switch {
+	case anchor < 0:
+		point = anchorMin
+	case anchor > 0:
+		point = anchorMax
+	default:
+		point = anchorMin + (anchorMax-anchorMin)/2
+	}
+
+	switch {
+	case gravity < 0:
+		point -= size
+	case gravity == 0:
+		point -= size / 2
+	}
+	return point + offset
+}
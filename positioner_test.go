@@ -0,0 +1,261 @@
+package multimon
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPositioner(t *testing.T) {
+	monitors := []Monitor{
+		{
+			Bounds:   Rect{0, 0, 1000, 800},
+			WorkArea: Rect{0, 0, 1000, 800},
+			Scale:    1.0,
+		},
+		{
+			// Secondary monitor with a taskbar at the bottom
+			Bounds:   Rect{1000, 0, 2000, 800},
+			WorkArea: Rect{1000, 0, 2000, 760},
+			Scale:    1.0,
+		},
+	}
+
+	button := Rect{100, 100, 200, 150}
+	small := Size{50, 30}
+	bottomButton := Rect{100, 760, 300, 790}
+	dropdown := Size{200, 100}
+	middleButton := Rect{100, 400, 300, 420}
+	rightPoint := Rect{980, 100, 980, 100}
+
+	tests := []struct {
+		name string
+		p    Positioner
+		want Rect
+	}{
+		// Anchor and gravity combinations
+		{
+			name: "anchor south-east gravity south-east",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravitySouthEast, Gravity: GravitySouthEast},
+			want: Rect{200, 150, 250, 180},
+		},
+		{
+			name: "anchor north-west gravity north-west",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravityNorthWest, Gravity: GravityNorthWest},
+			want: Rect{50, 70, 100, 100},
+		},
+		{
+			name: "anchor north-east gravity north-east",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravityNorthEast, Gravity: GravityNorthEast},
+			want: Rect{200, 70, 250, 100},
+		},
+		{
+			name: "anchor south-west gravity south-west",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravitySouthWest, Gravity: GravitySouthWest},
+			want: Rect{50, 150, 100, 180},
+		},
+		{
+			name: "anchor center gravity center",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravityCenter, Gravity: GravityCenter},
+			want: Rect{125, 110, 175, 140},
+		},
+		{
+			name: "anchor north gravity north",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravityNorth, Gravity: GravityNorth},
+			want: Rect{125, 70, 175, 100},
+		},
+		{
+			name: "anchor south gravity south",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravitySouth, Gravity: GravitySouth},
+			want: Rect{125, 150, 175, 180},
+		},
+		{
+			name: "anchor west gravity west",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravityWest, Gravity: GravityWest},
+			want: Rect{50, 110, 100, 140},
+		},
+		{
+			name: "anchor east gravity east",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravityEast, Gravity: GravityEast},
+			want: Rect{200, 110, 250, 140},
+		},
+		{
+			name: "dropdown below button",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravitySouthWest, Gravity: GravitySouthEast},
+			want: Rect{100, 150, 150, 180},
+		},
+		{
+			name: "offset",
+			p:    Positioner{Size: small, AnchorRect: button, Anchor: GravitySouthWest, Gravity: GravitySouthEast, Offset: Point{5, 2}},
+			want: Rect{105, 152, 155, 182},
+		},
+		{
+			name: "empty anchor rect is a point",
+			p:    Positioner{Size: small, AnchorRect: Rect{300, 300, 300, 300}, Anchor: GravitySouthEast, Gravity: GravitySouthEast},
+			want: Rect{300, 300, 350, 330},
+		},
+
+		// Vertical constraints
+		{
+			name: "constrained without adjustments",
+			p:    Positioner{Size: dropdown, AnchorRect: bottomButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast},
+			want: Rect{100, 790, 300, 890},
+		},
+		{
+			name: "flip y",
+			p:    Positioner{Size: dropdown, AnchorRect: bottomButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipY},
+			want: Rect{100, 660, 300, 760},
+		},
+		{
+			name: "slide y",
+			p:    Positioner{Size: dropdown, AnchorRect: bottomButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustSlideY},
+			want: Rect{100, 700, 300, 800},
+		},
+		{
+			name: "resize y",
+			p:    Positioner{Size: dropdown, AnchorRect: bottomButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustResizeY},
+			want: Rect{100, 790, 300, 800},
+		},
+		{
+			name: "flip takes precedence over slide",
+			p:    Positioner{Size: dropdown, AnchorRect: bottomButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipY | AdjustSlideY | AdjustResizeY},
+			want: Rect{100, 660, 300, 760},
+		},
+		{
+			name: "flip y with offset",
+			p:    Positioner{Size: dropdown, AnchorRect: bottomButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, Offset: Point{0, 4}, ConstraintAdjustment: AdjustFlipY},
+			want: Rect{100, 656, 300, 756},
+		},
+		{
+			name: "flip that does not fit is not applied",
+			p:    Positioner{Size: Size{200, 780}, AnchorRect: middleButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipY},
+			want: Rect{100, 420, 300, 1200},
+		},
+		{
+			name: "failed flip falls back to slide",
+			p:    Positioner{Size: Size{200, 780}, AnchorRect: middleButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipY | AdjustSlideY},
+			want: Rect{100, 20, 300, 800},
+		},
+		{
+			name: "failed flip falls back to resize",
+			p:    Positioner{Size: Size{200, 780}, AnchorRect: middleButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipY | AdjustResizeY},
+			want: Rect{100, 420, 300, 800},
+		},
+		{
+			name: "slide keeps top edge visible for oversized popup",
+			p:    Positioner{Size: Size{200, 900}, AnchorRect: middleButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustSlideY},
+			want: Rect{100, 0, 300, 900},
+		},
+		{
+			name: "slide then resize oversized popup",
+			p:    Positioner{Size: Size{200, 900}, AnchorRect: middleButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustSlideY | AdjustResizeY},
+			want: Rect{100, 0, 300, 800},
+		},
+		{
+			name: "slide up from top edge",
+			p:    Positioner{Size: small, AnchorRect: Rect{100, 10, 200, 20}, Anchor: GravityNorthWest, Gravity: GravityNorthEast, ConstraintAdjustment: AdjustSlideY},
+			want: Rect{100, 0, 150, 30},
+		},
+
+		// Horizontal constraints
+		{
+			name: "context menu at right edge without adjustments",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: rightPoint, Anchor: GravitySouthEast, Gravity: GravitySouthEast},
+			want: Rect{980, 100, 1080, 150},
+		},
+		{
+			name: "flip x",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: rightPoint, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipX},
+			want: Rect{880, 100, 980, 150},
+		},
+		{
+			name: "flip x with offset",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: rightPoint, Anchor: GravitySouthEast, Gravity: GravitySouthEast, Offset: Point{5, 0}, ConstraintAdjustment: AdjustFlipX},
+			want: Rect{875, 100, 975, 150},
+		},
+		{
+			name: "slide x",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: rightPoint, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustSlideX},
+			want: Rect{900, 100, 1000, 150},
+		},
+		{
+			name: "resize x",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: rightPoint, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustResizeX},
+			want: Rect{980, 100, 1000, 150},
+		},
+		{
+			name: "slide x from left edge",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: Rect{20, 100, 40, 120}, Anchor: GravityWest, Gravity: GravityWest, ConstraintAdjustment: AdjustSlideX},
+			want: Rect{0, 85, 100, 135},
+		},
+		{
+			name: "y adjustments do not affect x",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: rightPoint, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipY | AdjustSlideY | AdjustResizeY},
+			want: Rect{980, 100, 1080, 150},
+		},
+		{
+			name: "x adjustments do not affect y",
+			p:    Positioner{Size: dropdown, AnchorRect: bottomButton, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipX | AdjustSlideX | AdjustResizeX},
+			want: Rect{100, 790, 300, 890},
+		},
+
+		// Both axes
+		{
+			name: "flip both axes in bottom-right corner",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: Rect{980, 780, 980, 780}, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipX | AdjustFlipY},
+			want: Rect{880, 730, 980, 780},
+		},
+		{
+			name: "slide both axes in bottom-right corner",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: Rect{980, 780, 980, 780}, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustSlideX | AdjustSlideY},
+			want: Rect{900, 750, 1000, 800},
+		},
+		{
+			name: "flip x and slide y",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: Rect{980, 780, 980, 780}, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipX | AdjustSlideY},
+			want: Rect{880, 750, 980, 800},
+		},
+
+		// Multiple monitors
+		{
+			name: "constrained by the monitor holding the anchor",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: Rect{1010, 100, 1050, 120}, Anchor: GravitySouthWest, Gravity: GravitySouthWest, ConstraintAdjustment: AdjustFlipX},
+			want: Rect{1050, 120, 1150, 170},
+		},
+		{
+			name: "work area excludes taskbar",
+			p:    Positioner{Size: Size{100, 50}, AnchorRect: Rect{1100, 720, 1200, 740}, Anchor: GravitySouthWest, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustFlipY},
+			want: Rect{1100, 670, 1200, 720},
+		},
+		{
+			name: "invalid resize is not applied",
+			p:    Positioner{Size: Size{50, 30}, AnchorRect: Rect{2100, 100, 2150, 120}, Anchor: GravitySouthEast, Gravity: GravitySouthEast, ConstraintAdjustment: AdjustResizeX},
+			want: Rect{2150, 120, 2200, 150},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.p.Position(monitors)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid size", func(t *testing.T) {
+		p := Positioner{Size: Size{0, 10}, AnchorRect: button}
+		if _, err := p.Position(monitors); !errors.Is(err, ErrInvalidDimensions) {
+			t.Errorf("got error %v, want %v", err, ErrInvalidDimensions)
+		}
+	})
+
+	t.Run("no monitors", func(t *testing.T) {
+		p := Positioner{Size: small, AnchorRect: button}
+		if _, err := p.Position(nil); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("got error %v, want %v", err, ErrNoMonitors)
+		}
+	})
+}
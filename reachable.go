@@ -0,0 +1,101 @@
+package multimon
+
+import (
+	"fmt"
+	"math"
+)
+
+// GrabRegion specifies the part of a window that must remain reachable
+// by the user, such as the title bar
+type GrabRegion struct {
+	// StripHeight is the height of the grab region at the top of the window
+	// in logical units. If 0, the grab region is the whole window.
+	StripHeight int
+	// MinVisibleX and MinVisibleY are the minimum width and height of the
+	// part of the grab region that must lie inside a work area, in logical units.
+	// If 0, the whole width (height) of the grab region must be visible.
+	MinVisibleX int
+	MinVisibleY int
+}
+
+// FitKeepReachable is a less aggressive alternative to FitToNearestMonitor
+// that allows windows to be partly off-screen. It only guarantees that
+// a part of the grab region of the window lies inside the work area of some
+// monitor, so that the user can still grab and move the window.
+// Logical units of the grab region are converted with the scale of each
+// monitor considered.
+//
+// If the grab region is reachable, the window is returned unchanged.
+// Otherwise, the window is moved by the smallest distance that makes the grab
+// region reachable on one of the monitors. The window size is never changed.
+//
+// Returns error if window has invalid dimensions or no valid monitors are available.
+// Returns the window rect and the scale factor of the monitor the grab region
+// is reachable on.
+func FitKeepReachable(monitors []Monitor, window Rect, grab GrabRegion) (Rect, float64, error) {
+	if err := validateRect(window); err != nil {
+		return window, 1.0, fmt.Errorf("invalid window: %w", err)
+	}
+
+	var best *Monitor
+	bestDX, bestDY := 0, 0
+	minDistance := math.MaxInt
+	for i := range monitors {
+		m := &monitors[i]
+		if validateMonitor(*m) != nil {
+			continue
+		}
+
+		g := grabRect(window, grab, m.Scale)
+		needX := visibleExtent(grab.MinVisibleX, g.Right-g.Left, m.WorkArea.Right-m.WorkArea.Left, m.Scale)
+		needY := visibleExtent(grab.MinVisibleY, g.Bottom-g.Top, m.WorkArea.Bottom-m.WorkArea.Top, m.Scale)
+		dx := reachOffset(g.Left, g.Right, m.WorkArea.Left, m.WorkArea.Right, needX)
+		dy := reachOffset(g.Top, g.Bottom, m.WorkArea.Top, m.WorkArea.Bottom, needY)
+
+		if d := abs(dx) + abs(dy); d < minDistance {
+			best, bestDX, bestDY, minDistance = m, dx, dy, d
+		}
+	}
+	if best == nil {
+		return window, 1.0, ErrNoMonitors
+	}
+
+	return Rect{
+		Left:   window.Left + bestDX,
+		Top:    window.Top + bestDY,
+		Right:  window.Right + bestDX,
+		Bottom: window.Bottom + bestDY,
+	}, best.Scale, nil
+}
+
+// grabRect returns the grab region of a window in screen units
+func grabRect(window Rect, grab GrabRegion, scale float64) Rect {
+	g := window
+	if grab.StripHeight > 0 {
+		g.Bottom = min(window.Bottom, window.Top+max(1, int(float64(grab.StripHeight)*scale)))
+	}
+	return g
+}
+
+// visibleExtent calculates the required visible extent in screen units,
+// limited by the grab region size and the work area size
+func visibleExtent(minVisible, grabSize, areaSize int, scale float64) int {
+	need := grabSize
+	if minVisible > 0 {
+		need = min(need, max(1, int(float64(minVisible)*scale)))
+	}
+	return min(need, areaSize)
+}
+
+// reachOffset calculates the smallest offset that makes [lo, hi) overlap
+// [areaMin, areaMax) by at least need
+func reachOffset(lo, hi, areaMin, areaMax, need int) int {
+	switch {
+	case hi < areaMin+need:
+		return areaMin + need - hi
+	case lo > areaMax-need:
+		return areaMax - need - lo
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,124 @@
+package multimon
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFitKeepReachable(t *testing.T) {
+	monitors := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 2.0},
+	}
+	titleBar := GrabRegion{StripHeight: 30, MinVisibleX: 100, MinVisibleY: 10}
+
+	tests := []struct {
+		name      string
+		monitors  []Monitor
+		window    Rect
+		grab      GrabRegion
+		want      Rect
+		wantScale float64
+		wantErr   error
+	}{
+		{
+			name:      "fully visible",
+			monitors:  monitors,
+			window:    Rect{100, 100, 900, 700},
+			grab:      titleBar,
+			want:      Rect{100, 100, 900, 700},
+			wantScale: 1.0,
+		},
+		{
+			name:      "parked partly off-screen",
+			monitors:  monitors,
+			window:    Rect{-700, 100, 100, 700},
+			grab:      titleBar,
+			want:      Rect{-700, 100, 100, 700},
+			wantScale: 1.0,
+		},
+		{
+			name:      "too far left",
+			monitors:  monitors,
+			window:    Rect{-750, 100, 50, 700},
+			grab:      titleBar,
+			want:      Rect{-700, 100, 100, 700},
+			wantScale: 1.0,
+		},
+		{
+			name:      "title bar above the screen",
+			monitors:  monitors,
+			window:    Rect{100, -25, 900, 575},
+			grab:      titleBar,
+			want:      Rect{100, -20, 900, 580},
+			wantScale: 1.0,
+		},
+		{
+			name:      "title bar behind the taskbar",
+			monitors:  monitors,
+			window:    Rect{100, 1035, 900, 1635},
+			grab:      titleBar,
+			want:      Rect{100, 1030, 900, 1630},
+			wantScale: 1.0,
+		},
+		{
+			name:      "grab region scaled per monitor",
+			monitors:  monitors,
+			window:    Rect{3800, 100, 4600, 700},
+			grab:      titleBar,
+			want:      Rect{3640, 100, 4440, 700},
+			wantScale: 2.0,
+		},
+		{
+			name:      "whole window by default",
+			monitors:  monitors,
+			window:    Rect{-100, 100, 700, 700},
+			grab:      GrabRegion{},
+			want:      Rect{0, 100, 800, 700},
+			wantScale: 1.0,
+		},
+		{
+			name:      "grab region larger than work area",
+			monitors:  monitors[:1],
+			window:    Rect{-100, -100, 2100, 1300},
+			grab:      GrabRegion{},
+			want:      Rect{-100, -100, 2100, 1300},
+			wantScale: 1.0,
+		},
+		{
+			name:     "invalid window",
+			monitors: monitors,
+			window:   Rect{100, 100, 100, 700},
+			grab:     titleBar,
+			wantErr:  ErrInvalidDimensions,
+		},
+		{
+			name:     "no monitors",
+			monitors: nil,
+			window:   Rect{100, 100, 900, 700},
+			grab:     titleBar,
+			wantErr:  ErrNoMonitors,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, scale, err := FitKeepReachable(tt.monitors, tt.window, tt.grab)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if scale != tt.wantScale {
+				t.Errorf("got scale %v, want %v", scale, tt.wantScale)
+			}
+		})
+	}
+}
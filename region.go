@@ -0,0 +1,189 @@
+package multimon
+
+// Region is an area of the virtual desktop represented as a union of
+// non-overlapping rectangles in screen units. The zero value is an empty region.
+// Regions are immutable; operations return new regions.
+type Region struct {
+	rects []Rect
+}
+
+// NewRegion creates a region covering the union of the given rects.
+// Empty rects are ignored.
+func NewRegion(rects ...Rect) Region {
+	var g Region
+	for _, r := range rects {
+		g = g.Union(Region{rects: []Rect{r}})
+	}
+	return g
+}
+
+// DesktopRegion returns the region covered by the bounds of all monitors
+func DesktopRegion(monitors []Monitor) Region {
+	rects := make([]Rect, 0, len(monitors))
+	for _, m := range monitors {
+		rects = append(rects, m.Bounds)
+	}
+	return NewRegion(rects...)
+}
+
+// WorkAreaRegion returns the region covered by the work areas of all monitors
+func WorkAreaRegion(monitors []Monitor) Region {
+	rects := make([]Rect, 0, len(monitors))
+	for _, m := range monitors {
+		rects = append(rects, m.WorkArea)
+	}
+	return NewRegion(rects...)
+}
+
+// VisibleFraction returns the fraction of r that lies on any monitor,
+// from 0.0 (off-screen) to 1.0 (fully visible).
+// Returns 0 if r is empty.
+func VisibleFraction(monitors []Monitor, r Rect) float64 {
+	return DesktopRegion(monitors).Coverage(r)
+}
+
+// Rects returns the non-overlapping rects making up the region
+func (g Region) Rects() []Rect {
+	return append([]Rect(nil), g.rects...)
+}
+
+// IsEmpty returns true if the region has no area
+func (g Region) IsEmpty() bool {
+	return len(g.rects) == 0
+}
+
+// Union returns the region covered by g or o
+func (g Region) Union(o Region) Region {
+	rects := append([]Rect(nil), g.rects...)
+	for _, r := range o.rects {
+		if r.Right <= r.Left || r.Bottom <= r.Top {
+			continue
+		}
+		pieces := []Rect{r}
+		for _, existing := range g.rects {
+			pieces = subtractFromRects(pieces, existing)
+		}
+		rects = append(rects, pieces...)
+	}
+	return Region{rects: rects}
+}
+
+// Intersect returns the region covered by both g and o
+func (g Region) Intersect(o Region) Region {
+	var rects []Rect
+	for _, a := range g.rects {
+		for _, b := range o.rects {
+			if r, ok := intersectRect(a, b); ok {
+				rects = append(rects, r)
+			}
+		}
+	}
+	return Region{rects: rects}
+}
+
+// Subtract returns the region covered by g but not by o
+func (g Region) Subtract(o Region) Region {
+	rects := append([]Rect(nil), g.rects...)
+	for _, b := range o.rects {
+		rects = subtractFromRects(rects, b)
+	}
+	return Region{rects: rects}
+}
+
+// Area returns the area of the region in square screen units
+func (g Region) Area() int {
+	area := 0
+	for _, r := range g.rects {
+		area += (r.Right - r.Left) * (r.Bottom - r.Top)
+	}
+	return area
+}
+
+// Contains returns true if the point is within the region
+func (g Region) Contains(x, y int) bool {
+	for _, r := range g.rects {
+		if x >= r.Left && x < r.Right && y >= r.Top && y < r.Bottom {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsRect returns true if r lies entirely within the region.
+// Returns false if r is empty.
+func (g Region) ContainsRect(r Rect) bool {
+	if r.Right <= r.Left || r.Bottom <= r.Top {
+		return false
+	}
+	return NewRegion(r).Subtract(g).IsEmpty()
+}
+
+// Bounds returns the bounding box of the region.
+// Returns an empty Rect if the region is empty.
+func (g Region) Bounds() Rect {
+	if len(g.rects) == 0 {
+		return Rect{}
+	}
+	b := g.rects[0]
+	for _, r := range g.rects[1:] {
+		b.Left = min(b.Left, r.Left)
+		b.Top = min(b.Top, r.Top)
+		b.Right = max(b.Right, r.Right)
+		b.Bottom = max(b.Bottom, r.Bottom)
+	}
+	return b
+}
+
+// IsRectangular returns true if the region is non-empty and equals its bounding box
+func (g Region) IsRectangular() bool {
+	b := g.Bounds()
+	return !g.IsEmpty() && g.Area() == (b.Right-b.Left)*(b.Bottom-b.Top)
+}
+
+// Coverage returns the fraction of r covered by the region,
+// from 0.0 to 1.0. Returns 0 if r is empty.
+func (g Region) Coverage(r Rect) float64 {
+	if r.Right <= r.Left || r.Bottom <= r.Top {
+		return 0
+	}
+	covered := g.Intersect(Region{rects: []Rect{r}}).Area()
+	return float64(covered) / float64((r.Right-r.Left)*(r.Bottom-r.Top))
+}
+
+// intersectRect returns the intersection of two rects,
+// false if they do not overlap
+func intersectRect(a, b Rect) (Rect, bool) {
+	r := Rect{
+		Left:   max(a.Left, b.Left),
+		Top:    max(a.Top, b.Top),
+		Right:  min(a.Right, b.Right),
+		Bottom: min(a.Bottom, b.Bottom),
+	}
+	return r, r.Left < r.Right && r.Top < r.Bottom
+}
+
+// subtractFromRects removes b from each of the rects, splitting them into
+// up to four non-overlapping pieces: above, below, left and right of b
+func subtractFromRects(rects []Rect, b Rect) []Rect {
+	var result []Rect
+	for _, a := range rects {
+		i, ok := intersectRect(a, b)
+		if !ok {
+			result = append(result, a)
+			continue
+		}
+		if i.Top > a.Top {
+			result = append(result, Rect{Left: a.Left, Top: a.Top, Right: a.Right, Bottom: i.Top})
+		}
+		if i.Bottom < a.Bottom {
+			result = append(result, Rect{Left: a.Left, Top: i.Bottom, Right: a.Right, Bottom: a.Bottom})
+		}
+		if i.Left > a.Left {
+			result = append(result, Rect{Left: a.Left, Top: i.Top, Right: i.Left, Bottom: i.Bottom})
+		}
+		if i.Right < a.Right {
+			result = append(result, Rect{Left: i.Right, Top: i.Top, Right: a.Right, Bottom: i.Bottom})
+		}
+	}
+	return result
+}
@@ -0,0 +1,192 @@
+package multimon
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegion(t *testing.T) {
+	tests := []struct {
+		name       string
+		region     Region
+		wantArea   int
+		wantBounds Rect
+		wantRect   bool
+	}{
+		{
+			name:       "empty",
+			region:     Region{},
+			wantArea:   0,
+			wantBounds: Rect{},
+			wantRect:   false,
+		},
+		{
+			name:       "empty rects are ignored",
+			region:     NewRegion(Rect{10, 10, 10, 20}, Rect{0, 0, 5, -5}),
+			wantArea:   0,
+			wantBounds: Rect{},
+			wantRect:   false,
+		},
+		{
+			name:       "single rect",
+			region:     NewRegion(Rect{0, 0, 100, 50}),
+			wantArea:   5000,
+			wantBounds: Rect{0, 0, 100, 50},
+			wantRect:   true,
+		},
+		{
+			name:       "overlapping rects",
+			region:     NewRegion(Rect{0, 0, 100, 100}, Rect{50, 50, 150, 150}),
+			wantArea:   17500,
+			wantBounds: Rect{0, 0, 150, 150},
+			wantRect:   false,
+		},
+		{
+			name:       "adjacent rects forming a rectangle",
+			region:     NewRegion(Rect{0, 0, 100, 100}, Rect{100, 0, 200, 100}, Rect{0, 100, 200, 150}),
+			wantArea:   30000,
+			wantBounds: Rect{0, 0, 200, 150},
+			wantRect:   true,
+		},
+		{
+			name:       "union",
+			region:     NewRegion(Rect{0, 0, 100, 100}).Union(NewRegion(Rect{0, 0, 50, 200})),
+			wantArea:   15000,
+			wantBounds: Rect{0, 0, 100, 200},
+			wantRect:   false,
+		},
+		{
+			name:       "intersect",
+			region:     NewRegion(Rect{0, 0, 100, 100}, Rect{200, 0, 300, 100}).Intersect(NewRegion(Rect{50, 50, 250, 150})),
+			wantArea:   5000,
+			wantBounds: Rect{50, 50, 250, 100},
+			wantRect:   false,
+		},
+		{
+			name:       "subtract hole",
+			region:     NewRegion(Rect{0, 0, 100, 100}).Subtract(NewRegion(Rect{25, 25, 75, 75})),
+			wantArea:   7500,
+			wantBounds: Rect{0, 0, 100, 100},
+			wantRect:   false,
+		},
+		{
+			name:       "subtract everything",
+			region:     NewRegion(Rect{0, 0, 100, 100}).Subtract(NewRegion(Rect{-10, -10, 110, 110})),
+			wantArea:   0,
+			wantBounds: Rect{},
+			wantRect:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.region.Area(); got != tt.wantArea {
+				t.Errorf("got area %v, want %v", got, tt.wantArea)
+			}
+			if got := tt.region.Bounds(); got != tt.wantBounds {
+				t.Errorf("got bounds %v, want %v", got, tt.wantBounds)
+			}
+			if got := tt.region.IsRectangular(); got != tt.wantRect {
+				t.Errorf("got rectangular %v, want %v", got, tt.wantRect)
+			}
+			rects := tt.region.Rects()
+			for i, a := range rects {
+				for _, b := range rects[i+1:] {
+					if getOverlapArea(a, b) != 0 {
+						t.Errorf("rects %v and %v overlap", a, b)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRegionContains(t *testing.T) {
+	g := NewRegion(Rect{0, 0, 100, 100}).Subtract(NewRegion(Rect{25, 25, 75, 75}))
+
+	points := []struct {
+		x, y int
+		want bool
+	}{
+		{0, 0, true},
+		{10, 50, true},
+		{50, 50, false},
+		{75, 75, true},
+		{100, 50, false},
+		{-1, 50, false},
+	}
+	for _, p := range points {
+		if got := g.Contains(p.x, p.y); got != p.want {
+			t.Errorf("Contains(%d, %d): got %v, want %v", p.x, p.y, got, p.want)
+		}
+	}
+
+	rects := []struct {
+		r    Rect
+		want bool
+	}{
+		{Rect{0, 0, 100, 25}, true},
+		{Rect{0, 0, 25, 100}, true},
+		{Rect{0, 0, 50, 50}, false},
+		{Rect{90, 90, 110, 110}, false},
+		{Rect{10, 10, 10, 10}, false},
+	}
+	for _, tt := range rects {
+		if got := g.ContainsRect(tt.r); got != tt.want {
+			t.Errorf("ContainsRect(%v): got %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestDesktopRegion(t *testing.T) {
+	monitors := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 1.0},
+		{Bounds: Rect{3840, 200, 5120, 1224}, WorkArea: Rect{3840, 200, 5120, 1224}, Scale: 1.0},
+	}
+
+	desktop := DesktopRegion(monitors[:2])
+	if !desktop.IsRectangular() {
+		t.Errorf("side by side monitors: expected rectangular desktop")
+	}
+	if DesktopRegion(monitors).IsRectangular() {
+		t.Errorf("offset monitor: expected non-rectangular desktop")
+	}
+	if WorkAreaRegion(monitors[:2]).IsRectangular() {
+		t.Errorf("taskbar on one monitor: expected non-rectangular work area")
+	}
+	if got, want := WorkAreaRegion(monitors[:2]).Area(), 1920*1040+1920*1080; got != want {
+		t.Errorf("work area: got area %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name string
+		r    Rect
+		want float64
+	}{
+		{name: "fully visible", r: Rect{100, 100, 500, 400}, want: 1.0},
+		{name: "spanning monitors", r: Rect{1800, 100, 2000, 200}, want: 1.0},
+		{name: "half off-screen", r: Rect{-200, 100, 200, 200}, want: 0.5},
+		{name: "in the gap above offset monitor", r: Rect{3840, 0, 4040, 400}, want: 0.5},
+		{name: "off-screen", r: Rect{-500, -500, -100, -100}, want: 0.0},
+		{name: "empty rect", r: Rect{100, 100, 100, 100}, want: 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := VisibleFraction(monitors, tt.r)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("rects are copied", func(t *testing.T) {
+		g := NewRegion(Rect{0, 0, 10, 10})
+		rects := g.Rects()
+		rects[0] = Rect{}
+		if !reflect.DeepEqual(g.Rects(), []Rect{{0, 0, 10, 10}}) {
+			t.Errorf("region modified through Rects: %v", g.Rects())
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package multimon
+
+import (
+	"fmt"
+	"math"
+)
+
+// RepositionMode specifies how RepositionProportional maps a window
+// from an old work area onto a new one
+type RepositionMode int
+
+const (
+	// RepositionStretch maps every window edge to the same relative position
+	// in the new work area, so the window size changes in proportion to the work area
+	RepositionStretch RepositionMode = iota
+	// RepositionKeepSize keeps the window's logical size and preserves its
+	// relative position: a centered window stays centered and a window
+	// touching an edge of the old work area touches the same edge of the new one
+	RepositionKeepSize
+)
+
+// RepositionProportional moves a window from an old work area to the work area of
+// monitor m, preserving its position relative to the work area instead of its
+// absolute coordinates. This is useful when a monitor's resolution changes or
+// when a window moves to a monitor with a different work area size.
+// Input window coordinates and oldWorkArea are in screen units.
+// windowScale specifies what scale factor the window was designed for:
+// - If 0.0: keep window as is, no rescaling needed
+// - If > 0.0: rescale window from windowScale to monitor's scale (RepositionKeepSize only)
+// The result is fitted to the new work area.
+// Returns error if window, old work area or monitor are invalid.
+// Returns the repositioned rect and the monitor's scale factor.
+func RepositionProportional(window Rect, windowScale float64, oldWorkArea Rect, m *Monitor, mode RepositionMode) (Rect, float64, error) {
+	if err := validateRect(window); err != nil {
+		return window, windowScale, fmt.Errorf("invalid window: %w", err)
+	}
+	if err := validateRect(oldWorkArea); err != nil {
+		return window, windowScale, fmt.Errorf("invalid old work area: %w", err)
+	}
+	if m == nil {
+		return window, windowScale, fmt.Errorf("invalid monitor: nil")
+	}
+	if err := validateMonitor(*m); err != nil {
+		return window, windowScale, err
+	}
+
+	wa := m.WorkArea
+	var left, top, width, height int
+	switch mode {
+	case RepositionStretch:
+		var right, bottom int
+		left, right = stretchDimension(window.Left, window.Right, oldWorkArea.Left, oldWorkArea.Right, wa.Left, wa.Right)
+		top, bottom = stretchDimension(window.Top, window.Bottom, oldWorkArea.Top, oldWorkArea.Bottom, wa.Top, wa.Bottom)
+		width, height = right-left, bottom-top
+
+	default:
+		width = window.Right - window.Left
+		height = window.Bottom - window.Top
+		if windowScale > 0.0 {
+			width = int(float64(width) * (m.Scale / windowScale))
+			height = int(float64(height) * (m.Scale / windowScale))
+		}
+		left = keepRelativePosition(window.Left, window.Right-window.Left, oldWorkArea.Left, oldWorkArea.Right, width, wa.Left, wa.Right)
+		top = keepRelativePosition(window.Top, window.Bottom-window.Top, oldWorkArea.Top, oldWorkArea.Bottom, height, wa.Top, wa.Bottom)
+	}
+
+	newLeft, newWidth := fitRectDimension(left, width, wa.Left, wa.Right)
+	newTop, newHeight := fitRectDimension(top, height, wa.Top, wa.Bottom)
+
+	return Rect{
+		Left:   newLeft,
+		Top:    newTop,
+		Right:  newLeft + newWidth,
+		Bottom: newTop + newHeight,
+	}, m.Scale, nil
+}
+
+// stretchDimension maps both edges of a dimension from old bounds to new bounds
+func stretchDimension(lo, hi, oldMin, oldMax, newMin, newMax int) (int, int) {
+	factor := float64(newMax-newMin) / float64(oldMax-oldMin)
+	newLo := newMin + int(math.Round(float64(lo-oldMin)*factor))
+	newHi := newMin + int(math.Round(float64(hi-oldMin)*factor))
+	return newLo, newHi
+}
+
+// keepRelativePosition calculates the position of a dimension of size newSize
+// within new bounds that corresponds to the position of a dimension of size
+// oldSize within old bounds. The free space on both sides keeps its proportion.
+// If the dimension did not fit the old bounds, its center is mapped instead.
+func keepRelativePosition(pos, oldSize, oldMin, oldMax, newSize, newMin, newMax int) int {
+	oldSlack := oldMax - oldMin - oldSize
+	if oldSlack <= 0 {
+		center := float64(pos-oldMin) + float64(oldSize)/2
+		newCenter := center * float64(newMax-newMin) / float64(oldMax-oldMin)
+		return newMin + int(math.Round(newCenter-float64(newSize)/2))
+	}
+
+	f := float64(pos-oldMin) / float64(oldSlack)
+	f = math.Max(0, math.Min(1, f))
+	return newMin + int(math.Round(f*float64(newMax-newMin-newSize)))
+}
@@ -0,0 +1,176 @@
+package multimon
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRepositionProportional(t *testing.T) {
+	uhd := Rect{0, 0, 3840, 2160}
+	fhd := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 0, 1920, 1080},
+		Scale:    1.0,
+	}
+	fhdTaskbar := &Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 40, 1920, 1080},
+		Scale:    1.0,
+	}
+
+	tests := []struct {
+		name        string
+		window      Rect
+		scale       float64
+		oldWorkArea Rect
+		monitor     *Monitor
+		mode        RepositionMode
+		want        Rect
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:        "stretch centered window",
+			window:      Rect{960, 540, 2880, 1620},
+			oldWorkArea: uhd,
+			monitor:     fhd,
+			mode:        RepositionStretch,
+			want:        Rect{480, 270, 1440, 810},
+		},
+		{
+			name:        "stretch bottom-right window",
+			window:      Rect{2840, 1560, 3840, 2160},
+			oldWorkArea: uhd,
+			monitor:     fhd,
+			mode:        RepositionStretch,
+			want:        Rect{1420, 780, 1920, 1080},
+		},
+		{
+			name:        "stretch with work area offset",
+			window:      Rect{0, 0, 1920, 1080},
+			oldWorkArea: uhd,
+			monitor:     fhdTaskbar,
+			mode:        RepositionStretch,
+			want:        Rect{0, 40, 960, 560},
+		},
+		{
+			name:        "keep size centered window stays centered",
+			window:      Rect{1520, 780, 2320, 1380},
+			oldWorkArea: uhd,
+			monitor:     fhd,
+			mode:        RepositionKeepSize,
+			want:        Rect{560, 240, 1360, 840},
+		},
+		{
+			name:        "keep size window at right edge stays at right edge",
+			window:      Rect{3040, 100, 3840, 700},
+			oldWorkArea: uhd,
+			monitor:     fhd,
+			mode:        RepositionKeepSize,
+			want:        Rect{1120, 31, 1920, 631},
+		},
+		{
+			name:        "keep size at top-left",
+			window:      Rect{0, 0, 800, 600},
+			oldWorkArea: uhd,
+			monitor:     fhdTaskbar,
+			mode:        RepositionKeepSize,
+			want:        Rect{0, 40, 800, 640},
+		},
+		{
+			name:        "keep logical size when scale changes",
+			window:      Rect{1520, 780, 2320, 1380},
+			scale:       2.0,
+			oldWorkArea: uhd,
+			monitor:     fhd,
+			mode:        RepositionKeepSize,
+			want:        Rect{760, 390, 1160, 690},
+		},
+		{
+			name:        "keep size larger than new work area",
+			window:      Rect{0, 0, 3000, 2000},
+			oldWorkArea: uhd,
+			monitor:     fhd,
+			mode:        RepositionKeepSize,
+			want:        Rect{0, 0, 1920, 1080},
+		},
+		{
+			name:        "keep size window larger than old work area maps center",
+			window:      Rect{-100, 0, 3940, 1000},
+			oldWorkArea: uhd,
+			monitor:     &Monitor{Bounds: Rect{0, 0, 7680, 4320}, WorkArea: Rect{0, 0, 7680, 4320}, Scale: 1.0},
+			mode:        RepositionKeepSize,
+			want:        Rect{1820, 0, 5860, 1000},
+		},
+		{
+			name:        "nil monitor",
+			window:      Rect{0, 0, 800, 600},
+			oldWorkArea: uhd,
+			wantErr:     true,
+			errContains: "nil",
+		},
+		{
+			name:        "invalid window",
+			window:      Rect{800, 600, 0, 0},
+			oldWorkArea: uhd,
+			monitor:     fhd,
+			wantErr:     true,
+			errContains: "invalid window",
+		},
+		{
+			name:        "invalid old work area",
+			window:      Rect{0, 0, 800, 600},
+			oldWorkArea: Rect{},
+			monitor:     fhd,
+			wantErr:     true,
+			errContains: "invalid old work area",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := RepositionProportional(tt.window, tt.scale, tt.oldWorkArea, tt.monitor, tt.mode)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error but got none")
+				} else if !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got rect %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindowStateRestoreResolutionChange(t *testing.T) {
+	before := Monitor{
+		Bounds:       Rect{0, 0, 3840, 2160},
+		WorkArea:     Rect{0, 0, 3840, 2160},
+		Scale:        1.0,
+		Manufacturer: "GSM",
+		Model:        "5B08",
+		Serial:       "1111",
+	}
+	after := before
+	after.Bounds = Rect{0, 0, 1920, 1080}
+	after.WorkArea = Rect{0, 0, 1920, 1080}
+
+	// Centered window
+	s := CaptureWindowState([]Monitor{before}, Rect{1520, 780, 2320, 1380}, false, false)
+
+	monitors := []Monitor{after}
+	p, err := s.Restore(monitors)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Rect{560, 240, 1360, 840}
+	if p.Rect != want {
+		t.Errorf("got rect %v, want %v", p.Rect, want)
+	}
+}
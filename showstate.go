@@ -0,0 +1,84 @@
+package multimon
+
+import "fmt"
+
+// ShowState specifies how a window is shown
+type ShowState int
+
+const (
+	// ShowNormal is a regular window occupying its normal rect
+	ShowNormal ShowState = iota
+	// ShowMinimized is a minimized window, it is restored to its normal rect
+	ShowMinimized
+	// ShowMaximized is a window maximized to the work area of a monitor
+	ShowMaximized
+	// ShowFullscreen is a window covering the full bounds of a monitor
+	ShowFullscreen
+)
+
+// ShowPlacement is the result of placing a window with a show state
+type ShowPlacement struct {
+	Show    ShowState // Show state of the window
+	Monitor *Monitor  // Target monitor (points into the monitors slice)
+	Normal  Rect      // Normal (restore) rect in screen units, fits the target monitor's work area
+	Frame   Rect      // Rect the window occupies in its show state, in screen units
+	Scale   float64   // Scale factor of the target monitor
+}
+
+// PlaceWithShowState selects the target monitor for a window in the given show state
+// and returns a normal (restore) rect that fits that monitor's work area.
+// Input coordinates are in screen units.
+// windowScale specifies what scale factor the normal rect was designed for:
+// - If 0.0: keep window as is, no rescaling needed
+// - If > 0.0: rescale window from windowScale to monitor's scale
+// showRect is the rect the window occupied when it was maximized or fullscreen.
+// For ShowMaximized and ShowFullscreen it selects the target monitor; if it is
+// empty, or for other show states, the monitor is selected from the normal rect.
+// If the normal rect does not overlap the target monitor (for example, when the
+// monitor it was on no longer exists), it is centered in the target work area.
+//
+// Frame is the target work area for ShowMaximized, the target bounds for
+// ShowFullscreen, and the normal rect otherwise.
+// Returns error if the normal rect has invalid dimensions or no valid monitors are available.
+func PlaceWithShowState(monitors []Monitor, show ShowState, normal Rect, windowScale float64, showRect Rect) (ShowPlacement, error) {
+	if err := validateRect(normal); err != nil {
+		return ShowPlacement{}, fmt.Errorf("invalid window: %w", err)
+	}
+
+	var target *Monitor
+	if (show == ShowMaximized || show == ShowFullscreen) && validateRect(showRect) == nil {
+		target = findValidMonitor(monitors, showRect)
+	} else {
+		target = findValidMonitor(monitors, normal)
+	}
+	if target == nil {
+		return ShowPlacement{}, ErrNoMonitors
+	}
+
+	window := normal
+	var opts FitOptions
+	if getOverlapArea(normal, target.Bounds) == 0 {
+		// Rescale around the center so that the window stays centered
+		window = centerRect(normal, target.WorkArea)
+		opts.Anchor = ScaleAnchorCenter
+	}
+	rect, scale, err := FitToMonitorWithOptions(target, FitModeWorkArea, window, windowScale, opts)
+	if err != nil {
+		return ShowPlacement{}, err
+	}
+
+	p := ShowPlacement{
+		Show:    show,
+		Monitor: target,
+		Normal:  rect,
+		Frame:   rect,
+		Scale:   scale,
+	}
+	switch show {
+	case ShowMaximized:
+		p.Frame = target.WorkArea
+	case ShowFullscreen:
+		p.Frame = target.Bounds
+	}
+	return p, nil
+}
@@ -0,0 +1,172 @@
+package multimon
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPlaceWithShowState(t *testing.T) {
+	monitors := []Monitor{
+		{
+			Bounds:   Rect{0, 0, 1920, 1080},
+			WorkArea: Rect{0, 0, 1920, 1040},
+			Scale:    1.0,
+		},
+		{
+			Bounds:   Rect{1920, 0, 3840, 1080},
+			WorkArea: Rect{1920, 40, 3840, 1080},
+			Scale:    1.0,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		show       ShowState
+		normal     Rect
+		scale      float64
+		showRect   Rect
+		wantMon    int
+		wantNormal Rect
+		wantFrame  Rect
+	}{
+		{
+			name:       "normal window",
+			show:       ShowNormal,
+			normal:     Rect{100, 100, 900, 700},
+			wantMon:    0,
+			wantNormal: Rect{100, 100, 900, 700},
+			wantFrame:  Rect{100, 100, 900, 700},
+		},
+		{
+			name:       "minimized window restores to normal rect",
+			show:       ShowMinimized,
+			normal:     Rect{2000, 100, 2800, 700},
+			wantMon:    1,
+			wantNormal: Rect{2000, 100, 2800, 700},
+			wantFrame:  Rect{2000, 100, 2800, 700},
+		},
+		{
+			name:       "maximized on monitor of normal rect",
+			show:       ShowMaximized,
+			normal:     Rect{2000, 100, 2800, 700},
+			wantMon:    1,
+			wantNormal: Rect{2000, 100, 2800, 700},
+			wantFrame:  Rect{1920, 40, 3840, 1080},
+		},
+		{
+			name:       "maximized on another monitor moves restore rect",
+			show:       ShowMaximized,
+			normal:     Rect{100, 100, 900, 700},
+			showRect:   Rect{1920, 40, 3840, 1080},
+			wantMon:    1,
+			wantNormal: Rect{2480, 260, 3280, 860},
+			wantFrame:  Rect{1920, 40, 3840, 1080},
+		},
+		{
+			name:       "fullscreen uses bounds",
+			show:       ShowFullscreen,
+			normal:     Rect{100, 100, 900, 700},
+			showRect:   Rect{0, 0, 1920, 1080},
+			wantMon:    0,
+			wantNormal: Rect{100, 100, 900, 700},
+			wantFrame:  Rect{0, 0, 1920, 1080},
+		},
+		{
+			name:       "restore rect on removed monitor",
+			show:       ShowMaximized,
+			normal:     Rect{-1500, 100, -700, 700},
+			showRect:   Rect{0, 0, 1920, 1040},
+			wantMon:    0,
+			wantNormal: Rect{560, 220, 1360, 820},
+			wantFrame:  Rect{0, 0, 1920, 1040},
+		},
+		{
+			name:       "normal rect on removed monitor without show rect",
+			show:       ShowMaximized,
+			normal:     Rect{5000, 100, 5800, 700},
+			wantMon:    1,
+			wantNormal: Rect{2480, 260, 3280, 860},
+			wantFrame:  Rect{1920, 40, 3840, 1080},
+		},
+		{
+			name:       "restore rect is rescaled",
+			show:       ShowMaximized,
+			normal:     Rect{100, 100, 900, 700},
+			scale:      2.0,
+			showRect:   Rect{0, 0, 1920, 1040},
+			wantMon:    0,
+			wantNormal: Rect{100, 100, 500, 400},
+			wantFrame:  Rect{0, 0, 1920, 1040},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PlaceWithShowState(monitors, tt.show, tt.normal, tt.scale, tt.showRect)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Monitor != &monitors[tt.wantMon] {
+				t.Errorf("got monitor %v, want %v", got.Monitor, &monitors[tt.wantMon])
+			}
+			if got.Normal != tt.wantNormal {
+				t.Errorf("got normal %v, want %v", got.Normal, tt.wantNormal)
+			}
+			if got.Frame != tt.wantFrame {
+				t.Errorf("got frame %v, want %v", got.Frame, tt.wantFrame)
+			}
+			if got.Show != tt.show {
+				t.Errorf("got show %v, want %v", got.Show, tt.show)
+			}
+		})
+	}
+
+	t.Run("relocated rect is rescaled and centered", func(t *testing.T) {
+		hidpi := []Monitor{
+			{
+				Bounds:   Rect{0, 0, 3840, 2160},
+				WorkArea: Rect{0, 0, 3840, 2160},
+				Scale:    2.0,
+			},
+		}
+		got, err := PlaceWithShowState(hidpi, ShowNormal, Rect{-1000, 100, -200, 700}, 1.0, Rect{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := Rect{1120, 480, 2720, 1680}
+		if got.Normal != want {
+			t.Errorf("got normal %v, want %v", got.Normal, want)
+		}
+		if got.Scale != 2.0 {
+			t.Errorf("got scale %v, want %v", got.Scale, 2.0)
+		}
+	})
+
+	t.Run("no monitors", func(t *testing.T) {
+		_, err := PlaceWithShowState(nil, ShowMaximized, Rect{0, 0, 800, 600}, 0, Rect{})
+		if !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("got error %v, want %v", err, ErrNoMonitors)
+		}
+	})
+
+	t.Run("invalid normal rect", func(t *testing.T) {
+		_, err := PlaceWithShowState(monitors, ShowNormal, Rect{800, 600, 0, 0}, 0, Rect{})
+		if !errors.Is(err, ErrInvalidDimensions) {
+			t.Errorf("got error %v, want %v", err, ErrInvalidDimensions)
+		}
+	})
+
+	t.Run("invalid monitors are skipped", func(t *testing.T) {
+		withInvalid := []Monitor{
+			{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1080}, Scale: 0},
+			monitors[1],
+		}
+		got, err := PlaceWithShowState(withInvalid, ShowNormal, Rect{100, 100, 900, 700}, 0, Rect{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Monitor != &withInvalid[1] {
+			t.Errorf("got monitor %v, want %v", got.Monitor, &withInvalid[1])
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package multimon
+
+// DefaultSnapThreshold is the snapping distance in logical units used by
+// Snapper when no threshold is specified
+const DefaultSnapThreshold = 10
+
+// Edges is a set of rectangle edges
+type Edges uint
+
+const (
+	EdgeLeft Edges = 1 << iota
+	EdgeTop
+	EdgeRight
+	EdgeBottom
+
+	EdgeNone Edges = 0
+	EdgeAll        = EdgeLeft | EdgeTop | EdgeRight | EdgeBottom
+)
+
+// SnapTargets specifies which edges a window snaps to. Values can be combined.
+type SnapTargets uint
+
+const (
+	SnapMonitorEdges  SnapTargets = 1 << iota // Monitor bounds
+	SnapWorkAreaEdges                         // Monitor work areas
+	SnapWindowEdges                           // Edges of other windows
+
+	SnapAll = SnapMonitorEdges | SnapWorkAreaEdges | SnapWindowEdges
+)
+
+// Snapper snaps window edges to nearby monitor edges, work area edges and
+// edges of other windows during interactive move and resize.
+type Snapper struct {
+	// Threshold is the snapping distance in logical units, converted to screen
+	// units with the scale of the monitor holding the window.
+	// DefaultSnapThreshold is used if <= 0.
+	Threshold int
+	// Targets specifies which edges to snap to, SnapAll if zero
+	Targets SnapTargets
+}
+
+// snapLine is a vertical or horizontal line segment a window edge can snap to
+type snapLine struct {
+	pos    int // X of a vertical line or Y of a horizontal line
+	lo, hi int // Extent along the line
+}
+
+// SnapMove snaps a window being moved. The window keeps its size and is
+// shifted on each axis so that its closest edge within the threshold
+// coincides with a target edge. Window edges snap both to the outer edges of
+// other windows (placing windows side by side) and to their inner edges
+// (aligning windows). Only target edges that overlap the window
+// perpendicularly (within the threshold) are considered.
+//
+// Parameters:
+// - monitors: available monitors
+// - windows: other windows in screen units (excluding the moved window), may be nil
+// - proposed: proposed window rect in screen units
+//
+// Returns the snapped rect and the edges that coincide with a target edge.
+func (s Snapper) SnapMove(monitors []Monitor, windows []Rect, proposed Rect) (Rect, Edges) {
+	threshold := s.screenThreshold(monitors, proposed)
+	vertical, horizontal := s.snapLines(monitors, windows)
+
+	dx, snapX := nearestSnap(vertical, proposed.Top, proposed.Bottom, threshold, proposed.Left, proposed.Right)
+	dy, snapY := nearestSnap(horizontal, proposed.Left, proposed.Right, threshold, proposed.Top, proposed.Bottom)
+
+	r := proposed
+	if snapX {
+		r.Left += dx
+		r.Right += dx
+	}
+	if snapY {
+		r.Top += dy
+		r.Bottom += dy
+	}
+	return r, snappedEdges(vertical, horizontal, r, threshold, EdgeAll)
+}
+
+// SnapResize snaps the edges of a window being resized. Each edge in
+// the resizing set is moved independently to the nearest target edge within
+// the threshold; other edges stay in place. An edge is not snapped if that
+// would make the window empty. See SnapMove for the targets considered.
+//
+// Returns the snapped rect and the resizing edges that coincide with a target edge.
+func (s Snapper) SnapResize(monitors []Monitor, windows []Rect, proposed Rect, resizing Edges) (Rect, Edges) {
+	threshold := s.screenThreshold(monitors, proposed)
+	vertical, horizontal := s.snapLines(monitors, windows)
+
+	r := proposed
+	if resizing&EdgeLeft != 0 {
+		if d, ok := nearestSnap(vertical, proposed.Top, proposed.Bottom, threshold, proposed.Left); ok && r.Left+d < r.Right {
+			r.Left += d
+		}
+	}
+	if resizing&EdgeRight != 0 {
+		if d, ok := nearestSnap(vertical, proposed.Top, proposed.Bottom, threshold, proposed.Right); ok && r.Right+d > r.Left {
+			r.Right += d
+		}
+	}
+	if resizing&EdgeTop != 0 {
+		if d, ok := nearestSnap(horizontal, proposed.Left, proposed.Right, threshold, proposed.Top); ok && r.Top+d < r.Bottom {
+			r.Top += d
+		}
+	}
+	if resizing&EdgeBottom != 0 {
+		if d, ok := nearestSnap(horizontal, proposed.Left, proposed.Right, threshold, proposed.Bottom); ok && r.Bottom+d > r.Top {
+			r.Bottom += d
+		}
+	}
+	return r, snappedEdges(vertical, horizontal, r, threshold, resizing)
+}
+
+// screenThreshold converts the threshold to screen units using the scale of
+// the monitor holding most of the rect
+func (s Snapper) screenThreshold(monitors []Monitor, r Rect) int {
+	threshold := s.Threshold
+	if threshold <= 0 {
+		threshold = DefaultSnapThreshold
+	}
+	scale := 1.0
+	if m := findValidMonitor(monitors, r); m != nil {
+		scale = m.Scale
+	}
+	return int(float64(threshold) * scale)
+}
+
+// snapLines collects the vertical and horizontal target lines
+func (s Snapper) snapLines(monitors []Monitor, windows []Rect) (vertical, horizontal []snapLine) {
+	targets := s.Targets
+	if targets == 0 {
+		targets = SnapAll
+	}
+
+	addRect := func(r Rect) {
+		vertical = append(vertical,
+			snapLine{r.Left, r.Top, r.Bottom},
+			snapLine{r.Right, r.Top, r.Bottom})
+		horizontal = append(horizontal,
+			snapLine{r.Top, r.Left, r.Right},
+			snapLine{r.Bottom, r.Left, r.Right})
+	}
+
+	for _, m := range monitors {
+		if validateMonitor(m) != nil {
+			continue
+		}
+		if targets&SnapMonitorEdges != 0 {
+			addRect(m.Bounds)
+		}
+		if targets&SnapWorkAreaEdges != 0 {
+			addRect(m.WorkArea)
+		}
+	}
+	if targets&SnapWindowEdges != 0 {
+		for _, w := range windows {
+			if validateRect(w) != nil {
+				continue
+			}
+			addRect(w)
+		}
+	}
+	return vertical, horizontal
+}
+
+// nearestSnap finds the smallest offset within threshold that moves one of
+// the given edge positions onto a line overlapping [lo, hi) perpendicularly.
+// Ties are resolved in favor of the earlier line and edge.
+func nearestSnap(lines []snapLine, lo, hi, threshold int, edges ...int) (int, bool) {
+	best, found := 0, false
+	for _, l := range lines {
+		if l.hi < lo-threshold || l.lo > hi+threshold {
+			continue
+		}
+		for _, e := range edges {
+			d := l.pos - e
+			if abs(d) <= threshold && (!found || abs(d) < abs(best)) {
+				best, found = d, true
+			}
+		}
+	}
+	return best, found
+}
+
+// snappedEdges returns the edges of r within mask that lie on a target line
+func snappedEdges(vertical, horizontal []snapLine, r Rect, threshold int, mask Edges) Edges {
+	onLine := func(lines []snapLine, pos, lo, hi int) bool {
+		d, ok := nearestSnap(lines, lo, hi, threshold, pos)
+		return ok && d == 0
+	}
+
+	var edges Edges
+	if mask&EdgeLeft != 0 && onLine(vertical, r.Left, r.Top, r.Bottom) {
+		edges |= EdgeLeft
+	}
+	if mask&EdgeRight != 0 && onLine(vertical, r.Right, r.Top, r.Bottom) {
+		edges |= EdgeRight
+	}
+	if mask&EdgeTop != 0 && onLine(horizontal, r.Top, r.Left, r.Right) {
+		edges |= EdgeTop
+	}
+	if mask&EdgeBottom != 0 && onLine(horizontal, r.Bottom, r.Left, r.Right) {
+		edges |= EdgeBottom
+	}
+	return edges
+}
@@ -0,0 +1,164 @@
+package multimon
+
+import "testing"
+
+var snapTestMonitors = []Monitor{
+	{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+	{Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 2.0},
+}
+
+func TestSnapperSnapMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		snapper   Snapper
+		windows   []Rect
+		proposed  Rect
+		want      Rect
+		wantEdges Edges
+	}{
+		{
+			name:      "no snap",
+			proposed:  Rect{500, 300, 1300, 900},
+			want:      Rect{500, 300, 1300, 900},
+			wantEdges: EdgeNone,
+		},
+		{
+			name:      "monitor edge",
+			proposed:  Rect{7, 300, 807, 900},
+			want:      Rect{0, 300, 800, 900},
+			wantEdges: EdgeLeft,
+		},
+		{
+			name:      "work area edge",
+			proposed:  Rect{500, 235, 1300, 1035},
+			want:      Rect{500, 240, 1300, 1040},
+			wantEdges: EdgeBottom,
+		},
+		{
+			name:      "corner",
+			proposed:  Rect{-4, 6, 796, 606},
+			want:      Rect{0, 0, 800, 600},
+			wantEdges: EdgeLeft | EdgeTop,
+		},
+		{
+			name:      "beyond threshold",
+			proposed:  Rect{12, 300, 812, 900},
+			want:      Rect{12, 300, 812, 900},
+			wantEdges: EdgeNone,
+		},
+		{
+			name:      "next to window",
+			windows:   []Rect{{1000, 200, 1400, 700}},
+			proposed:  Rect{395, 250, 995, 650},
+			want:      Rect{400, 250, 1000, 650},
+			wantEdges: EdgeRight,
+		},
+		{
+			name:      "next to and aligned with window",
+			windows:   []Rect{{1000, 200, 1400, 700}},
+			proposed:  Rect{395, 203, 995, 603},
+			want:      Rect{400, 200, 1000, 600},
+			wantEdges: EdgeRight | EdgeTop,
+		},
+		{
+			name:      "window not overlapping perpendicularly",
+			windows:   []Rect{{1000, 800, 1400, 1000}},
+			proposed:  Rect{395, 100, 995, 500},
+			want:      Rect{395, 100, 995, 500},
+			wantEdges: EdgeNone,
+		},
+		{
+			name:      "threshold scaled on high DPI monitor",
+			proposed:  Rect{1935, 300, 2735, 900},
+			want:      Rect{1920, 300, 2720, 900},
+			wantEdges: EdgeLeft,
+		},
+		{
+			name:      "custom threshold",
+			snapper:   Snapper{Threshold: 20},
+			proposed:  Rect{15, 300, 815, 900},
+			want:      Rect{0, 300, 800, 900},
+			wantEdges: EdgeLeft,
+		},
+		{
+			name:      "restricted targets",
+			snapper:   Snapper{Targets: SnapWindowEdges},
+			proposed:  Rect{7, 300, 807, 900},
+			want:      Rect{7, 300, 807, 900},
+			wantEdges: EdgeNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, edges := tt.snapper.SnapMove(snapTestMonitors, tt.windows, tt.proposed)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if edges != tt.wantEdges {
+				t.Errorf("got edges %v, want %v", edges, tt.wantEdges)
+			}
+		})
+	}
+}
+
+func TestSnapperSnapResize(t *testing.T) {
+	tests := []struct {
+		name      string
+		windows   []Rect
+		proposed  Rect
+		resizing  Edges
+		want      Rect
+		wantEdges Edges
+	}{
+		{
+			name:      "right edge",
+			proposed:  Rect{100, 100, 1915, 600},
+			resizing:  EdgeRight,
+			want:      Rect{100, 100, 1920, 600},
+			wantEdges: EdgeRight,
+		},
+		{
+			name:      "edges not being resized stay in place",
+			proposed:  Rect{5, 100, 800, 600},
+			resizing:  EdgeRight,
+			want:      Rect{5, 100, 800, 600},
+			wantEdges: EdgeNone,
+		},
+		{
+			name:      "bottom-right corner",
+			proposed:  Rect{100, 100, 1915, 1035},
+			resizing:  EdgeRight | EdgeBottom,
+			want:      Rect{100, 100, 1920, 1040},
+			wantEdges: EdgeRight | EdgeBottom,
+		},
+		{
+			name:      "top-left corner to window",
+			windows:   []Rect{{0, 0, 400, 300}},
+			proposed:  Rect{396, 305, 1000, 800},
+			resizing:  EdgeLeft | EdgeTop,
+			want:      Rect{400, 300, 1000, 800},
+			wantEdges: EdgeLeft | EdgeTop,
+		},
+		{
+			name:      "snap would make window empty",
+			windows:   []Rect{{1008, 0, 1400, 700}},
+			proposed:  Rect{1000, 100, 1005, 600},
+			resizing:  EdgeLeft,
+			want:      Rect{1000, 100, 1005, 600},
+			wantEdges: EdgeNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, edges := Snapper{}.SnapResize(snapTestMonitors, tt.windows, tt.proposed, tt.resizing)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if edges != tt.wantEdges {
+				t.Errorf("got edges %v, want %v", edges, tt.wantEdges)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package multimon
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrNotGrid is returned when bezel compensation is requested for
+	// monitors that do not form a grid
+	ErrNotGrid = errors.New("monitors do not form a grid")
+	// ErrNoPhysicalSize is returned when bezels are specified in millimeters
+	// and the physical size of a monitor is unknown
+	ErrNoPhysicalSize = errors.New("monitor physical size is unknown")
+)
+
+// BezelUnit specifies the unit of bezel widths
+type BezelUnit int
+
+const (
+	BezelPixels      BezelUnit = iota // Screen units
+	BezelMillimeters                  // Millimeters, requires Monitor.WidthMM and Monitor.HeightMM
+)
+
+// Bezel specifies the gap between the display areas of adjacent monitors,
+// including the bezels of both monitors
+type Bezel struct {
+	Horizontal float64   // Gap between adjacent columns
+	Vertical   float64   // Gap between adjacent rows
+	Unit       BezelUnit // Unit of the gaps
+}
+
+// SpanGrid describes monitors arranged in a grid of equally aligned cells
+type SpanGrid struct {
+	Columns int
+	Rows    int
+	// Cells holds indices into the monitor list, indexed by [row][column]
+	Cells [][]int
+}
+
+// SpanViewport maps a region of the spanned content onto a monitor
+type SpanViewport struct {
+	Monitor *Monitor
+	Column  int  // Grid column, 0 if the monitors do not form a grid
+	Row     int  // Grid row, 0 if the monitors do not form a grid
+	Source  Rect // Region of the content shown on the monitor, in content coordinates
+}
+
+// Span describes a window spanning a group of monitors
+type Span struct {
+	// Rect is the spanning window rect in screen units
+	Rect Rect
+	// Grid is set if the monitors form a grid, nil otherwise
+	Grid *SpanGrid
+	// Content is the size of the content in content units (screen units),
+	// including the areas hidden behind the bezels
+	Content Size
+	// Viewports maps content regions to monitors, in monitor order
+	Viewports []SpanViewport
+}
+
+// SpanningRect returns the smallest rect covering the bounds of all monitors.
+// Returns an empty Rect if monitors is empty.
+func SpanningRect(monitors []Monitor) Rect {
+	if len(monitors) == 0 {
+		return Rect{}
+	}
+	r := monitors[0].Bounds
+	for _, m := range monitors[1:] {
+		r.Left = min(r.Left, m.Bounds.Left)
+		r.Top = min(r.Top, m.Bounds.Top)
+		r.Right = max(r.Right, m.Bounds.Right)
+		r.Bottom = max(r.Bottom, m.Bounds.Bottom)
+	}
+	return r
+}
+
+// DetectGrid checks whether the monitors form a clean grid: every column
+// consists of monitors with the same left and right edges, every row of
+// monitors with the same top and bottom edges, adjacent columns and rows
+// touch, and every cell holds exactly one monitor.
+// Returns false if the monitors do not form a grid.
+func DetectGrid(monitors []Monitor) (SpanGrid, bool) {
+	if len(monitors) == 0 {
+		return SpanGrid{}, false
+	}
+
+	columns := distinctSpans(monitors, func(r Rect) (int, int) { return r.Left, r.Right })
+	rows := distinctSpans(monitors, func(r Rect) (int, int) { return r.Top, r.Bottom })
+	if columns == nil || rows == nil || len(columns)*len(rows) != len(monitors) {
+		return SpanGrid{}, false
+	}
+
+	grid := SpanGrid{
+		Columns: len(columns),
+		Rows:    len(rows),
+		Cells:   make([][]int, len(rows)),
+	}
+	for row := range grid.Cells {
+		grid.Cells[row] = make([]int, len(columns))
+		for col := range grid.Cells[row] {
+			grid.Cells[row][col] = -1
+		}
+	}
+	for i, m := range monitors {
+		col := sort.SearchInts(columns, m.Bounds.Left)
+		row := sort.SearchInts(rows, m.Bounds.Top)
+		if grid.Cells[row][col] >= 0 {
+			return SpanGrid{}, false
+		}
+		grid.Cells[row][col] = i
+	}
+	return grid, true
+}
+
+// distinctSpans returns the sorted distinct start positions of the spans
+// selected from monitor bounds, or nil if spans with the same start have
+// different ends or consecutive spans do not touch
+func distinctSpans(monitors []Monitor, span func(Rect) (int, int)) []int {
+	ends := map[int]int{}
+	for _, m := range monitors {
+		lo, hi := span(m.Bounds)
+		if end, ok := ends[lo]; ok && end != hi {
+			return nil
+		}
+		ends[lo] = hi
+	}
+
+	starts := make([]int, 0, len(ends))
+	for lo := range ends {
+		starts = append(starts, lo)
+	}
+	sort.Ints(starts)
+	for i := 1; i < len(starts); i++ {
+		if ends[starts[i-1]] != starts[i] {
+			return nil
+		}
+	}
+	return starts
+}
+
+// SpanMonitors computes a window spanning the given monitors and the mapping
+// of the spanned content to each monitor. With bezel compensation, the
+// content includes the areas hidden behind the bezels, so that imagery lines
+// up across physical monitor borders: the viewport of a monitor in column c
+// and row r is shifted by c horizontal gaps and r vertical gaps.
+//
+// Bezels in millimeters are converted to screen units using the average pixel
+// density of the monitors (Bounds size divided by WidthMM and HeightMM).
+// A zero Bezel disables compensation, and any monitor arrangement is allowed;
+// the content then matches the spanning rect.
+//
+// Returns error if no monitors are given, bezels are specified for monitors
+// that do not form a grid, or physical sizes are unknown for bezels in millimeters.
+func SpanMonitors(monitors []Monitor, bezel Bezel) (Span, error) {
+	if len(monitors) == 0 {
+		return Span{}, ErrNoMonitors
+	}
+	for _, m := range monitors {
+		if err := validateMonitor(m); err != nil {
+			return Span{}, err
+		}
+	}
+
+	rect := SpanningRect(monitors)
+	span := Span{Rect: rect}
+
+	var gapX, gapY int
+	grid, isGrid := DetectGrid(monitors)
+	if isGrid {
+		span.Grid = &grid
+	}
+	if bezel.Horizontal != 0 || bezel.Vertical != 0 {
+		if !isGrid {
+			return Span{}, ErrNotGrid
+		}
+		var err error
+		gapX, gapY, err = bezelGaps(monitors, bezel)
+		if err != nil {
+			return Span{}, err
+		}
+	}
+
+	span.Content = Size{
+		Width:  rect.Right - rect.Left + gapX*max(0, grid.Columns-1),
+		Height: rect.Bottom - rect.Top + gapY*max(0, grid.Rows-1),
+	}
+
+	span.Viewports = make([]SpanViewport, len(monitors))
+	for i := range monitors {
+		span.Viewports[i].Monitor = &monitors[i]
+	}
+	if isGrid {
+		for row, cells := range grid.Cells {
+			for col, i := range cells {
+				span.Viewports[i].Column = col
+				span.Viewports[i].Row = row
+			}
+		}
+	}
+	for i := range span.Viewports {
+		v := &span.Viewports[i]
+		b := v.Monitor.Bounds
+		offsetX := b.Left - rect.Left + v.Column*gapX
+		offsetY := b.Top - rect.Top + v.Row*gapY
+		v.Source = Rect{
+			Left:   offsetX,
+			Top:    offsetY,
+			Right:  offsetX + b.Right - b.Left,
+			Bottom: offsetY + b.Bottom - b.Top,
+		}
+	}
+	return span, nil
+}
+
+// bezelGaps converts bezel gaps to screen units
+func bezelGaps(monitors []Monitor, bezel Bezel) (gapX, gapY int, err error) {
+	if bezel.Unit != BezelMillimeters {
+		return int(bezel.Horizontal), int(bezel.Vertical), nil
+	}
+
+	var pixelsX, pixelsY, mmX, mmY int
+	for _, m := range monitors {
+		if m.WidthMM <= 0 || m.HeightMM <= 0 {
+			return 0, 0, fmt.Errorf("%w: %q", ErrNoPhysicalSize, m.Name)
+		}
+		pixelsX += m.Bounds.Right - m.Bounds.Left
+		pixelsY += m.Bounds.Bottom - m.Bounds.Top
+		mmX += m.WidthMM
+		mmY += m.HeightMM
+	}
+	gapX = int(bezel.Horizontal*float64(pixelsX)/float64(mmX) + 0.5)
+	gapY = int(bezel.Vertical*float64(pixelsY)/float64(mmY) + 0.5)
+	return gapX, gapY, nil
+}
@@ -0,0 +1,209 @@
+package multimon
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// wallMonitor creates a 1920x1080 video wall monitor at the given grid cell
+func wallMonitor(col, row int) Monitor {
+	b := Rect{col * 1920, row * 1080, (col + 1) * 1920, (row + 1) * 1080}
+	return Monitor{Bounds: b, WorkArea: b, Scale: 1.0, WidthMM: 527, HeightMM: 296}
+}
+
+func TestSpanningRect(t *testing.T) {
+	tests := []struct {
+		name     string
+		monitors []Monitor
+		want     Rect
+	}{
+		{
+			name: "no monitors",
+			want: Rect{},
+		},
+		{
+			name:     "single monitor",
+			monitors: []Monitor{wallMonitor(0, 0)},
+			want:     Rect{0, 0, 1920, 1080},
+		},
+		{
+			name: "monitors with different sizes",
+			monitors: []Monitor{
+				{Bounds: Rect{0, 0, 1920, 1080}},
+				{Bounds: Rect{-1280, 200, 0, 1224}},
+			},
+			want: Rect{-1280, 0, 1920, 1224},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SpanningRect(tt.monitors)
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		monitors []Monitor
+		want     SpanGrid
+		wantOK   bool
+	}{
+		{
+			name:     "2x2 grid in any order",
+			monitors: []Monitor{wallMonitor(1, 0), wallMonitor(0, 0), wallMonitor(0, 1), wallMonitor(1, 1)},
+			want:     SpanGrid{Columns: 2, Rows: 2, Cells: [][]int{{1, 0}, {2, 3}}},
+			wantOK:   true,
+		},
+		{
+			name:     "single row",
+			monitors: []Monitor{wallMonitor(0, 0), wallMonitor(1, 0), wallMonitor(2, 0)},
+			want:     SpanGrid{Columns: 3, Rows: 1, Cells: [][]int{{0, 1, 2}}},
+			wantOK:   true,
+		},
+		{
+			name:     "missing cell",
+			monitors: []Monitor{wallMonitor(0, 0), wallMonitor(1, 0), wallMonitor(0, 1)},
+			wantOK:   false,
+		},
+		{
+			name: "different heights in a row",
+			monitors: []Monitor{
+				{Bounds: Rect{0, 0, 1920, 1080}},
+				{Bounds: Rect{1920, 0, 3840, 1200}},
+			},
+			wantOK: false,
+		},
+		{
+			name: "gap between monitors",
+			monitors: []Monitor{
+				{Bounds: Rect{0, 0, 1920, 1080}},
+				{Bounds: Rect{2000, 0, 3920, 1080}},
+			},
+			wantOK: false,
+		},
+		{
+			name:     "duplicate monitors",
+			monitors: []Monitor{wallMonitor(0, 0), wallMonitor(0, 0)},
+			wantOK:   false,
+		},
+		{
+			name:   "no monitors",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DetectGrid(tt.monitors)
+			if ok != tt.wantOK {
+				t.Fatalf("got ok %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpanMonitors(t *testing.T) {
+	wall := []Monitor{wallMonitor(1, 0), wallMonitor(0, 0), wallMonitor(0, 1), wallMonitor(1, 1)}
+	mixed := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+		{Bounds: Rect{1920, 200, 3200, 1224}, WorkArea: Rect{1920, 200, 3200, 1224}, Scale: 1.0},
+	}
+
+	tests := []struct {
+		name        string
+		monitors    []Monitor
+		bezel       Bezel
+		wantRect    Rect
+		wantContent Size
+		wantSources []Rect
+		wantErr     error
+	}{
+		{
+			name:        "grid without bezels",
+			monitors:    wall,
+			wantRect:    Rect{0, 0, 3840, 2160},
+			wantContent: Size{3840, 2160},
+			wantSources: []Rect{{1920, 0, 3840, 1080}, {0, 0, 1920, 1080}, {0, 1080, 1920, 2160}, {1920, 1080, 3840, 2160}},
+		},
+		{
+			name:        "grid with pixel bezels",
+			monitors:    wall,
+			bezel:       Bezel{Horizontal: 40, Vertical: 30},
+			wantRect:    Rect{0, 0, 3840, 2160},
+			wantContent: Size{3880, 2190},
+			wantSources: []Rect{{1960, 0, 3880, 1080}, {0, 0, 1920, 1080}, {0, 1110, 1920, 2190}, {1960, 1110, 3880, 2190}},
+		},
+		{
+			name:     "grid with millimeter bezels",
+			monitors: wall,
+			bezel:    Bezel{Horizontal: 10, Vertical: 10, Unit: BezelMillimeters},
+			// 1920px / 527mm * 10mm = 36.4, 1080px / 296mm * 10mm = 36.5
+			wantRect:    Rect{0, 0, 3840, 2160},
+			wantContent: Size{3876, 2196},
+			wantSources: []Rect{{1956, 0, 3876, 1080}, {0, 0, 1920, 1080}, {0, 1116, 1920, 2196}, {1956, 1116, 3876, 2196}},
+		},
+		{
+			name:        "non-grid without bezels",
+			monitors:    mixed,
+			wantRect:    Rect{0, 0, 3200, 1224},
+			wantContent: Size{3200, 1224},
+			wantSources: []Rect{{0, 0, 1920, 1080}, {1920, 200, 3200, 1224}},
+		},
+		{
+			name:     "non-grid with bezels",
+			monitors: mixed,
+			bezel:    Bezel{Horizontal: 40},
+			wantErr:  ErrNotGrid,
+		},
+		{
+			name:     "unknown physical size",
+			monitors: []Monitor{wallMonitor(0, 0), {Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 1.0}},
+			bezel:    Bezel{Horizontal: 10, Unit: BezelMillimeters},
+			wantErr:  ErrNoPhysicalSize,
+		},
+		{
+			name:    "no monitors",
+			wantErr: ErrNoMonitors,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SpanMonitors(tt.monitors, tt.bezel)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Rect != tt.wantRect {
+				t.Errorf("got rect %v, want %v", got.Rect, tt.wantRect)
+			}
+			if got.Content != tt.wantContent {
+				t.Errorf("got content %v, want %v", got.Content, tt.wantContent)
+			}
+			var sources []Rect
+			for i, v := range got.Viewports {
+				if v.Monitor != &tt.monitors[i] {
+					t.Errorf("viewport %d: got monitor %v, want %v", i, v.Monitor, tt.monitors[i])
+				}
+				sources = append(sources, v.Source)
+			}
+			if !reflect.DeepEqual(sources, tt.wantSources) {
+				t.Errorf("got sources %v, want %v", sources, tt.wantSources)
+			}
+		})
+	}
+}
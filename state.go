@@ -0,0 +1,305 @@
+package multimon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+)
+
+// WindowStateVersion is the current schema version of WindowState.
+// It is written by CaptureWindowState and checked by WindowState.Restore.
+const WindowStateVersion = 1
+
+// ErrUnsupportedVersion is returned when a saved WindowState has a schema
+// version that this package does not know how to restore
+var ErrUnsupportedVersion = errors.New("unsupported window state version")
+
+// MonitorDescriptor describes a monitor as it was when a window state was saved.
+// It is used to recognize the same monitor in a later session.
+type MonitorDescriptor struct {
+	Bounds       Rect    // Monitor bounds in screen units
+	WorkArea     Rect    // Work area in screen units
+	Scale        float64 // Scale factor
+	Name         string  // Connector or device name
+	Manufacturer string  // Manufacturer from EDID
+	Model        string  // Model from EDID
+	Serial       string  // Serial number from EDID
+}
+
+// rectJSON is the JSON representation of a Rect inside saved window states.
+// Rect itself is left untagged to keep its existing encoding.
+type rectJSON struct {
+	Left   int `json:"left"`
+	Top    int `json:"top"`
+	Right  int `json:"right"`
+	Bottom int `json:"bottom"`
+}
+
+// toRectJSON converts r to its JSON representation
+func toRectJSON(r Rect) rectJSON {
+	return rectJSON{Left: r.Left, Top: r.Top, Right: r.Right, Bottom: r.Bottom}
+}
+
+// rect converts r back to a Rect
+func (r rectJSON) rect() Rect {
+	return Rect{Left: r.Left, Top: r.Top, Right: r.Right, Bottom: r.Bottom}
+}
+
+// monitorDescriptorJSON is the JSON representation of a MonitorDescriptor
+type monitorDescriptorJSON struct {
+	Bounds       rectJSON `json:"bounds"`
+	WorkArea     rectJSON `json:"work_area"`
+	Scale        float64  `json:"scale"`
+	Name         string   `json:"name,omitempty"`
+	Manufacturer string   `json:"manufacturer,omitempty"`
+	Model        string   `json:"model,omitempty"`
+	Serial       string   `json:"serial,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding rect edges with lowercase keys
+func (d MonitorDescriptor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(monitorDescriptorJSON{
+		Bounds:       toRectJSON(d.Bounds),
+		WorkArea:     toRectJSON(d.WorkArea),
+		Scale:        d.Scale,
+		Name:         d.Name,
+		Manufacturer: d.Manufacturer,
+		Model:        d.Model,
+		Serial:       d.Serial,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *MonitorDescriptor) UnmarshalJSON(data []byte) error {
+	var j monitorDescriptorJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*d = MonitorDescriptor{
+		Bounds:       j.Bounds.rect(),
+		WorkArea:     j.WorkArea.rect(),
+		Scale:        j.Scale,
+		Name:         j.Name,
+		Manufacturer: j.Manufacturer,
+		Model:        j.Model,
+		Serial:       j.Serial,
+	}
+	return nil
+}
+
+// DescribeMonitor returns a descriptor for the given monitor
+func DescribeMonitor(m Monitor) MonitorDescriptor {
+	return MonitorDescriptor{
+		Bounds:       m.Bounds,
+		WorkArea:     m.WorkArea,
+		Scale:        m.Scale,
+		Name:         m.Name,
+		Manufacturer: m.Manufacturer,
+		Model:        m.Model,
+		Serial:       m.Serial,
+	}
+}
+
+// WindowState holds the persistent part of a window's geometry.
+// It is designed to be stored as JSON between sessions and restored
+// with Restore, which validates the saved geometry against the monitors
+// available at restore time.
+type WindowState struct {
+	Version    int               // Schema version (WindowStateVersion)
+	Normal     Rect              // Normal (restored) window rect in screen units
+	Scale      float64           // Scale factor the window was saved at
+	Monitor    MonitorDescriptor // Monitor that held the window
+	Maximized  bool              // Window was maximized
+	Fullscreen bool              // Window was fullscreen
+	Layout     string            // Fingerprint of the monitor layout, see LayoutFingerprint
+}
+
+// windowStateJSON is the JSON representation of a WindowState
+type windowStateJSON struct {
+	Version    int               `json:"version"`
+	Normal     rectJSON          `json:"normal"`
+	Scale      float64           `json:"scale"`
+	Monitor    MonitorDescriptor `json:"monitor"`
+	Maximized  bool              `json:"maximized,omitempty"`
+	Fullscreen bool              `json:"fullscreen,omitempty"`
+	Layout     string            `json:"layout,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding rect edges with lowercase keys
+func (s WindowState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(windowStateJSON{
+		Version:    s.Version,
+		Normal:     toRectJSON(s.Normal),
+		Scale:      s.Scale,
+		Monitor:    s.Monitor,
+		Maximized:  s.Maximized,
+		Fullscreen: s.Fullscreen,
+		Layout:     s.Layout,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *WindowState) UnmarshalJSON(data []byte) error {
+	var j windowStateJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*s = WindowState{
+		Version:    j.Version,
+		Normal:     j.Normal.rect(),
+		Scale:      j.Scale,
+		Monitor:    j.Monitor,
+		Maximized:  j.Maximized,
+		Fullscreen: j.Fullscreen,
+		Layout:     j.Layout,
+	}
+	return nil
+}
+
+// Placement is the result of restoring a window state
+type Placement struct {
+	Rect       Rect     // Normal window rect in screen units
+	Scale      float64  // Scale factor of the target monitor
+	Monitor    *Monitor // Target monitor (points into the monitors slice passed to Restore)
+	Maximized  bool     // Window should be shown maximized
+	Fullscreen bool     // Window should be shown fullscreen
+}
+
+// CaptureWindowState records the current state of a window.
+// normal is the window's normal (restored) rect in screen units.
+// The monitor and scale are taken from the monitor that holds most of
+// the normal rect; if no monitors are available the scale is set to 1.0.
+func CaptureWindowState(monitors []Monitor, normal Rect, maximized, fullscreen bool) WindowState {
+	s := WindowState{
+		Version:    WindowStateVersion,
+		Normal:     normal,
+		Scale:      1.0,
+		Maximized:  maximized,
+		Fullscreen: fullscreen,
+		Layout:     LayoutFingerprint(monitors),
+	}
+	if m := FindMonitorFromScreenRect(monitors, normal, DefaultMonitorNearest); m != nil {
+		s.Scale = m.Scale
+		s.Monitor = DescribeMonitor(*m)
+	}
+	return s
+}
+
+// Restore validates the saved state against the given monitors and returns
+// a placement for the window:
+// 1. If the state was saved in the same monitor configuration (see LayoutFingerprint)
+// and the normal rect still lies within the work areas, it is returned unchanged,
+// even if it spans several monitors
+// 2. If the saved monitor can be matched with MatchMonitor, the normal rect is
+// translated to the matched monitor and fitted to its work area; if the work
+// area size has changed, the rect is moved with RepositionProportional instead
+// 3. Otherwise the normal rect is fitted with FitToNearestMonitor
+//
+// In the last two cases the rect is rescaled from the saved scale to the target monitor's scale.
+// Returns ErrUnsupportedVersion if the state has an unknown schema version,
+// and the errors of the fitting functions otherwise.
+func (s WindowState) Restore(monitors []Monitor) (Placement, error) {
+	if err := s.checkVersion(); err != nil {
+		return Placement{}, err
+	}
+
+	if s.Layout != "" && s.Layout == LayoutFingerprint(monitors) && validateRect(s.Normal) == nil &&
+		WorkAreaRegion(monitors).ContainsRect(s.Normal) {
+		m := FindMonitorFromScreenRect(monitors, s.Normal, DefaultMonitorNearest)
+		return Placement{
+			Rect:       s.Normal,
+			Scale:      m.Scale,
+			Monitor:    m,
+			Maximized:  s.Maximized,
+			Fullscreen: s.Fullscreen,
+		}, nil
+	}
+
+	if m, _ := MatchMonitor(monitors, s.Monitor); m != nil {
+		var rect Rect
+		var scale float64
+		var err error
+		if isSameSize(s.Monitor.WorkArea, m.WorkArea) || validateRect(s.Monitor.WorkArea) != nil {
+			window := TranslateToMonitor(s.Monitor, *m, s.Normal)
+			rect, scale, err = FitToMonitor(m, FitModeWorkArea, window, s.Scale)
+		} else {
+			rect, scale, err = RepositionProportional(s.Normal, s.Scale, s.Monitor.WorkArea, m, RepositionKeepSize)
+		}
+		if err != nil {
+			return Placement{}, err
+		}
+		return Placement{
+			Rect:       rect,
+			Scale:      scale,
+			Monitor:    m,
+			Maximized:  s.Maximized,
+			Fullscreen: s.Fullscreen,
+		}, nil
+	}
+
+	return s.restoreNearest(monitors)
+}
+
+// checkVersion returns ErrUnsupportedVersion if the state has an unknown schema version
+func (s WindowState) checkVersion() error {
+	if s.Version <= 0 || s.Version > WindowStateVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, s.Version)
+	}
+	return nil
+}
+
+// restoreNearest fits the normal rect with FitToNearestMonitor
+func (s WindowState) restoreNearest(monitors []Monitor) (Placement, error) {
+	rect, scale, err := FitToNearestMonitor(monitors, FitModeWorkArea, s.Normal, s.Scale, 0, 0)
+	if err != nil {
+		return Placement{}, err
+	}
+
+	return Placement{
+		Rect:       rect,
+		Scale:      scale,
+		Monitor:    FindMonitorFromScreenRect(monitors, rect, DefaultMonitorNearest),
+		Maximized:  s.Maximized,
+		Fullscreen: s.Fullscreen,
+	}, nil
+}
+
+// LayoutFingerprint returns a string that identifies a monitor configuration.
+// Two monitor lists produce the same fingerprint if they contain monitors
+// with the same bounds and scale factors, regardless of order.
+// Work areas are not included, so moving a taskbar does not change the fingerprint.
+// Returns an empty string if no monitors are available.
+func LayoutFingerprint(monitors []Monitor) string {
+	if len(monitors) == 0 {
+		return ""
+	}
+
+	sorted := make([]Monitor, len(monitors))
+	copy(sorted, monitors)
+	// Sort on all hashed fields so that monitors sharing an origin
+	// (mirrored or overlapping displays) are ordered deterministically
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i].Bounds, sorted[j].Bounds
+		switch {
+		case a.Left != b.Left:
+			return a.Left < b.Left
+		case a.Top != b.Top:
+			return a.Top < b.Top
+		case a.Right != b.Right:
+			return a.Right < b.Right
+		case a.Bottom != b.Bottom:
+			return a.Bottom < b.Bottom
+		default:
+			return sorted[i].Scale < sorted[j].Scale
+		}
+	})
+
+	h := fnv.New64a()
+	for _, m := range sorted {
+		fmt.Fprintf(h, "%d,%d,%d,%d@%g;", m.Bounds.Left, m.Bounds.Top, m.Bounds.Right, m.Bounds.Bottom, m.Scale)
+	}
+	return strconv.FormatUint(h.Sum64(), 16)
+}
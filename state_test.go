@@ -0,0 +1,263 @@
+package multimon
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestCaptureWindowState(t *testing.T) {
+	monitors := []Monitor{
+		{
+			Bounds:   Rect{0, 0, 1920, 1080},
+			WorkArea: Rect{0, 0, 1920, 1040},
+			Scale:    1.0,
+		},
+		{
+			Bounds:   Rect{1920, 0, 3840, 1080},
+			WorkArea: Rect{1920, 40, 3840, 1080},
+			Scale:    1.5,
+		},
+	}
+
+	t.Run("captures monitor and scale", func(t *testing.T) {
+		s := CaptureWindowState(monitors, Rect{2000, 100, 2800, 700}, true, false)
+		if s.Version != WindowStateVersion {
+			t.Errorf("got version %d, want %d", s.Version, WindowStateVersion)
+		}
+		if s.Scale != 1.5 {
+			t.Errorf("got scale %v, want 1.5", s.Scale)
+		}
+		if s.Monitor.Bounds != monitors[1].Bounds {
+			t.Errorf("got monitor bounds %v, want %v", s.Monitor.Bounds, monitors[1].Bounds)
+		}
+		if !s.Maximized || s.Fullscreen {
+			t.Errorf("got maximized=%v fullscreen=%v, want true false", s.Maximized, s.Fullscreen)
+		}
+		if s.Layout != LayoutFingerprint(monitors) {
+			t.Errorf("got layout %q, want %q", s.Layout, LayoutFingerprint(monitors))
+		}
+	})
+
+	t.Run("no monitors", func(t *testing.T) {
+		s := CaptureWindowState(nil, Rect{0, 0, 800, 600}, false, false)
+		if s.Scale != 1.0 {
+			t.Errorf("got scale %v, want 1.0", s.Scale)
+		}
+		if s.Layout != "" {
+			t.Errorf("got layout %q, want empty", s.Layout)
+		}
+	})
+}
+
+func TestWindowStateJSON(t *testing.T) {
+	s := WindowState{
+		Version:    WindowStateVersion,
+		Normal:     Rect{100, 200, 900, 800},
+		Scale:      1.25,
+		Monitor:    MonitorDescriptor{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.25},
+		Fullscreen: true,
+		Layout:     "abc",
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+
+	var got WindowState
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if got != s {
+		t.Errorf("round trip got %+v, want %+v", got, s)
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("unmarshal to map failed: %v", err)
+	}
+	normal, ok := raw["normal"].(map[string]any)
+	if !ok || normal["left"] != 100.0 || normal["bottom"] != 800.0 {
+		t.Errorf("unexpected normal rect encoding: %s", data)
+	}
+	monitor, ok := raw["monitor"].(map[string]any)
+	if !ok {
+		t.Fatalf("missing monitor: %s", data)
+	}
+	if bounds, ok := monitor["bounds"].(map[string]any); !ok || bounds["right"] != 1920.0 {
+		t.Errorf("unexpected monitor bounds encoding: %s", data)
+	}
+	if _, ok := raw["maximized"]; ok {
+		t.Errorf("false maximized flag should be omitted: %s", data)
+	}
+
+	t.Run("rect encoding is unchanged", func(t *testing.T) {
+		data, err := json.Marshal(Rect{1, 2, 3, 4})
+		if err != nil {
+			t.Fatalf("marshal failed: %v", err)
+		}
+		if want := `{"Left":1,"Top":2,"Right":3,"Bottom":4}`; string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+	})
+}
+
+func TestWindowStateRestore(t *testing.T) {
+	monitors := []Monitor{
+		{
+			Bounds:   Rect{0, 0, 1920, 1080},
+			WorkArea: Rect{0, 0, 1920, 1040},
+			Scale:    1.0,
+		},
+		{
+			Bounds:   Rect{1920, 0, 3840, 1080},
+			WorkArea: Rect{1920, 40, 3840, 1080},
+			Scale:    2.0,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		state     WindowState
+		want      Rect
+		wantScale float64
+		wantMon   int
+		wantErr   error
+	}{
+		{
+			name:      "same layout",
+			state:     WindowState{Version: 1, Normal: Rect{100, 100, 900, 700}, Scale: 1.0},
+			want:      Rect{100, 100, 900, 700},
+			wantScale: 1.0,
+			wantMon:   0,
+		},
+		{
+			name:      "rescaled to monitor",
+			state:     WindowState{Version: 1, Normal: Rect{2000, 100, 2400, 400}, Scale: 1.0},
+			want:      Rect{2000, 100, 2800, 700},
+			wantScale: 2.0,
+			wantMon:   1,
+		},
+		{
+			name:      "off screen is pulled back",
+			state:     WindowState{Version: 1, Normal: Rect{4000, 100, 4400, 400}, Scale: 2.0},
+			want:      Rect{3440, 100, 3840, 400},
+			wantScale: 2.0,
+			wantMon:   1,
+		},
+		{
+			name: "same configuration spanning two monitors",
+			state: WindowState{
+				Version: 1,
+				Normal:  Rect{1800, 100, 2600, 700},
+				Scale:   2.0,
+				Layout:  LayoutFingerprint(monitors),
+			},
+			want:      Rect{1800, 100, 2600, 700},
+			wantScale: 2.0,
+			wantMon:   1,
+		},
+		{
+			name: "same configuration off screen is pulled back",
+			state: WindowState{
+				Version: 1,
+				Normal:  Rect{4000, 100, 4400, 400},
+				Scale:   2.0,
+				Layout:  LayoutFingerprint(monitors),
+			},
+			want:      Rect{3440, 100, 3840, 400},
+			wantScale: 2.0,
+			wantMon:   1,
+		},
+		{
+			name:    "zero version",
+			state:   WindowState{Normal: Rect{100, 100, 900, 700}, Scale: 1.0},
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "future version",
+			state:   WindowState{Version: WindowStateVersion + 1, Normal: Rect{100, 100, 900, 700}, Scale: 1.0},
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "invalid rect",
+			state:   WindowState{Version: 1, Normal: Rect{900, 700, 100, 100}, Scale: 1.0},
+			wantErr: ErrInvalidDimensions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.state.Restore(monitors)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Rect != tt.want {
+				t.Errorf("got rect %v, want %v", got.Rect, tt.want)
+			}
+			if got.Scale != tt.wantScale {
+				t.Errorf("got scale %v, want %v", got.Scale, tt.wantScale)
+			}
+			if got.Monitor != &monitors[tt.wantMon] {
+				t.Errorf("got monitor %v, want %v", got.Monitor, &monitors[tt.wantMon])
+			}
+		})
+	}
+
+	t.Run("no monitors", func(t *testing.T) {
+		s := WindowState{Version: 1, Normal: Rect{100, 100, 900, 700}, Scale: 1.0}
+		if _, err := s.Restore(nil); !errors.Is(err, ErrNoMonitors) {
+			t.Errorf("got error %v, want %v", err, ErrNoMonitors)
+		}
+	})
+
+	t.Run("flags are preserved", func(t *testing.T) {
+		s := WindowState{Version: 1, Normal: Rect{100, 100, 900, 700}, Scale: 1.0, Maximized: true}
+		got, err := s.Restore(monitors)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !got.Maximized || got.Fullscreen {
+			t.Errorf("got maximized=%v fullscreen=%v, want true false", got.Maximized, got.Fullscreen)
+		}
+	})
+}
+
+func TestLayoutFingerprint(t *testing.T) {
+	a := Monitor{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0}
+	b := Monitor{Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 1.5}
+
+	if LayoutFingerprint(nil) != "" {
+		t.Error("expected empty fingerprint for no monitors")
+	}
+	if LayoutFingerprint([]Monitor{a, b}) != LayoutFingerprint([]Monitor{b, a}) {
+		t.Error("fingerprint should not depend on monitor order")
+	}
+
+	mirrored := Monitor{Bounds: Rect{0, 0, 1280, 1024}, WorkArea: Rect{0, 0, 1280, 1024}, Scale: 1.0}
+	if LayoutFingerprint([]Monitor{a, mirrored, b}) != LayoutFingerprint([]Monitor{b, mirrored, a}) {
+		t.Error("fingerprint should not depend on the order of monitors sharing an origin")
+	}
+	if LayoutFingerprint([]Monitor{a, b}) == LayoutFingerprint([]Monitor{a}) {
+		t.Error("fingerprint should change when a monitor is removed")
+	}
+
+	moved := a
+	moved.WorkArea = Rect{0, 40, 1920, 1080}
+	if LayoutFingerprint([]Monitor{a, b}) != LayoutFingerprint([]Monitor{moved, b}) {
+		t.Error("fingerprint should not depend on work area")
+	}
+
+	rescaled := b
+	rescaled.Scale = 2.0
+	if LayoutFingerprint([]Monitor{a, b}) == LayoutFingerprint([]Monitor{a, rescaled}) {
+		t.Error("fingerprint should change when scale changes")
+	}
+}
@@ -0,0 +1,134 @@
+package multimon
+
+// PlacementTarget specifies how to select the monitor for a new window
+type PlacementTarget int
+
+const (
+	// TargetPrimary selects the primary monitor, see FindPrimaryMonitor.
+	// If the primary monitor is invalid, the valid monitor nearest to (0,0) is used.
+	TargetPrimary PlacementTarget = iota
+	// TargetPointer selects the monitor under the mouse pointer
+	TargetPointer
+	// TargetRect selects the monitor holding most of a rect, such as the
+	// parent or currently active window
+	TargetRect
+	// TargetMonitor selects a specific monitor matched with MatchMonitor
+	TargetMonitor
+	// TargetLargest selects the monitor with the largest work area
+	TargetLargest
+	// TargetHighestDPI selects the monitor with the highest scale factor,
+	// preferring the largest work area among equal scale factors
+	TargetHighestDPI
+)
+
+// PlacementOptions specifies how to select the monitor for a new window
+type PlacementOptions struct {
+	// Targets are tried in order until one of them selects a monitor.
+	// The primary monitor is used if no target selects a monitor.
+	Targets []PlacementTarget
+	// Rect used by TargetRect in screen units
+	Rect Rect
+	// Monitor used by TargetMonitor
+	Monitor MonitorDescriptor
+	// Pointer position used by TargetPointer in screen units.
+	// If nil, the position is obtained with GetPointerPosition.
+	Pointer *Point
+}
+
+// SelectMonitor selects a monitor according to the placement options.
+// Targets are tried in order; a target that cannot be satisfied (no pointer
+// position, empty rect, unmatched monitor) falls through to the next one.
+// Invalid monitors are skipped.
+// Returns the primary monitor (as with TargetPrimary) if no target selects
+// a monitor, or nil if no valid monitors are available.
+func SelectMonitor(monitors []Monitor, opts PlacementOptions) *Monitor {
+	for _, target := range opts.Targets {
+		if m := selectTargetMonitor(monitors, target, opts); m != nil {
+			return m
+		}
+	}
+	return findValidPrimaryMonitor(monitors)
+}
+
+// findValidPrimaryMonitor returns the primary monitor if it is valid,
+// otherwise the valid monitor nearest to (0,0).
+// Returns nil if no valid monitors are available.
+func findValidPrimaryMonitor(monitors []Monitor) *Monitor {
+	if m := FindPrimaryMonitor(monitors); m != nil && validateMonitor(*m) == nil {
+		return m
+	}
+	return findValidMonitor(monitors, Rect{Right: 1, Bottom: 1})
+}
+
+// selectTargetMonitor selects a monitor for a single target,
+// returns nil if the target cannot be satisfied
+func selectTargetMonitor(monitors []Monitor, target PlacementTarget, opts PlacementOptions) *Monitor {
+	switch target {
+	case TargetPrimary:
+		return findValidPrimaryMonitor(monitors)
+
+	case TargetPointer:
+		pt := opts.Pointer
+		if pt == nil {
+			pos, ok := GetPointerPosition()
+			if !ok {
+				return nil
+			}
+			pt = &pos
+		}
+		m := FindMonitorFromScreenPoint(monitors, pt.X, pt.Y, DefaultMonitorNull)
+		if m == nil || validateMonitor(*m) != nil {
+			return nil
+		}
+		return m
+
+	case TargetRect:
+		if validateRect(opts.Rect) != nil {
+			return nil
+		}
+		return findValidMonitor(monitors, opts.Rect)
+
+	case TargetMonitor:
+		m, _ := MatchMonitor(monitors, opts.Monitor)
+		return m
+
+	case TargetLargest, TargetHighestDPI:
+		var best *Monitor
+		bestArea := 0
+		for i := range monitors {
+			m := &monitors[i]
+			if validateMonitor(*m) != nil {
+				continue
+			}
+			area := (m.WorkArea.Right - m.WorkArea.Left) * (m.WorkArea.Bottom - m.WorkArea.Top)
+			better := best == nil || area > bestArea
+			if best != nil && target == TargetHighestDPI && m.Scale != best.Scale {
+				better = m.Scale > best.Scale
+			}
+			if better {
+				best = m
+				bestArea = area
+			}
+		}
+		return best
+
+	default:
+		return nil
+	}
+}
+
+// InitialPlacementWithOptions calculates the initial window placement centered on
+// a monitor selected with SelectMonitor. Window size is determined by logic
+// implemented in CalcPlacementSize.
+//
+// Parameters:
+// - opts: monitor selection options
+// - desiredWidth, desiredHeight: preferred window size in logical units
+// - minWidth, minHeight: minimum required window size in logical units
+// - margin: minimum distance from work area edges in logical units
+//
+// Returns a Rect with the calculated window position and size in screen units,
+// and the scale factor of the selected monitor (1.0 if no monitor is available).
+func InitialPlacementWithOptions(opts PlacementOptions, desiredWidth, desiredHeight, minWidth, minHeight, margin int) (Rect, float64) {
+	return placeOnMonitor(SelectMonitor(GetMonitors(), opts), desiredWidth, desiredHeight, minWidth, minHeight, margin)
+}
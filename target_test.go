@@ -0,0 +1,132 @@
+package multimon
+
+import "testing"
+
+func TestSelectMonitor(t *testing.T) {
+	monitors := []Monitor{
+		{
+			// Primary monitor
+			Bounds:   Rect{0, 0, 1920, 1080},
+			WorkArea: Rect{0, 0, 1920, 1040},
+			Scale:    1.0,
+			Name:     "DP-1",
+		},
+		{
+			// Large low-DPI monitor
+			Bounds:   Rect{1920, 0, 5360, 1440},
+			WorkArea: Rect{1920, 0, 5360, 1440},
+			Scale:    1.0,
+			Name:     "DP-2",
+		},
+		{
+			// Small high-DPI monitor
+			Bounds:   Rect{-2560, 0, 0, 1600},
+			WorkArea: Rect{-2560, 0, 0, 1600},
+			Scale:    2.0,
+			Name:     "eDP-1",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		opts      PlacementOptions
+		wantIndex int
+	}{
+		{
+			name:      "no targets uses primary",
+			opts:      PlacementOptions{},
+			wantIndex: 0,
+		},
+		{
+			name:      "pointer",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetPointer}, Pointer: &Point{X: 3000, Y: 500}},
+			wantIndex: 1,
+		},
+		{
+			name:      "pointer outside monitors falls back",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetPointer, TargetLargest}, Pointer: &Point{X: 9000, Y: 500}},
+			wantIndex: 1,
+		},
+		{
+			name:      "rect of parent window",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetRect}, Rect: Rect{-1000, 100, 200, 900}},
+			wantIndex: 2,
+		},
+		{
+			name:      "off-screen rect uses nearest monitor",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetRect}, Rect: Rect{6000, 100, 6800, 700}},
+			wantIndex: 1,
+		},
+		{
+			name:      "empty rect falls back to primary",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetRect}},
+			wantIndex: 0,
+		},
+		{
+			name:      "monitor identity",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetMonitor}, Monitor: MonitorDescriptor{Name: "eDP-1"}},
+			wantIndex: 2,
+		},
+		{
+			name:      "unmatched monitor identity falls through",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetMonitor, TargetHighestDPI}, Monitor: MonitorDescriptor{Name: "HDMI-1"}},
+			wantIndex: 2,
+		},
+		{
+			name:      "largest",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetLargest}},
+			wantIndex: 1,
+		},
+		{
+			name:      "highest dpi",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetHighestDPI}},
+			wantIndex: 2,
+		},
+		{
+			name:      "first satisfied target wins",
+			opts:      PlacementOptions{Targets: []PlacementTarget{TargetRect, TargetPrimary, TargetLargest}},
+			wantIndex: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SelectMonitor(monitors, tt.opts)
+			if got != &monitors[tt.wantIndex] {
+				t.Errorf("got %v, want monitor %d", got, tt.wantIndex)
+			}
+		})
+	}
+
+	t.Run("no monitors", func(t *testing.T) {
+		if got := SelectMonitor(nil, PlacementOptions{Targets: []PlacementTarget{TargetLargest}}); got != nil {
+			t.Errorf("got %v, want nil", got)
+		}
+	})
+
+	t.Run("invalid primary monitor is skipped", func(t *testing.T) {
+		invalid := []Monitor{
+			{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1080}, Scale: 0},
+			{Bounds: Rect{1920, 0, 3840, 1080}, WorkArea: Rect{1920, 0, 3840, 1080}, Scale: 1.0},
+		}
+		for _, opts := range []PlacementOptions{{}, {Targets: []PlacementTarget{TargetPrimary}}} {
+			if got := SelectMonitor(invalid, opts); got != &invalid[1] {
+				t.Errorf("targets %v: got %v, want %v", opts.Targets, got, &invalid[1])
+			}
+		}
+		if got := SelectMonitor(invalid[:1], PlacementOptions{}); got != nil {
+			t.Errorf("got %v, want nil", got)
+		}
+	})
+
+	t.Run("highest dpi prefers larger among equal scales", func(t *testing.T) {
+		equal := []Monitor{
+			{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1080}, Scale: 1.5},
+			{Bounds: Rect{1920, 0, 4480, 1440}, WorkArea: Rect{1920, 0, 4480, 1440}, Scale: 1.5},
+		}
+		got := SelectMonitor(equal, PlacementOptions{Targets: []PlacementTarget{TargetHighestDPI}})
+		if got != &equal[1] {
+			t.Errorf("got %v, want %v", got, &equal[1])
+		}
+	})
+}
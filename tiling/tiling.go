@@ -0,0 +1,183 @@
+// Package tiling splits a monitor's work area into non-overlapping tiles
+// for grid, columns, rows, master-stack and spiral window layouts.
+package tiling
+
+import (
+	"math"
+
+	"github.com/adnsv/multimon/types"
+)
+
+// Layout specifies how the work area is split into tiles
+type Layout int
+
+const (
+	Grid        Layout = iota // Near-square grid, the last row is stretched to full width
+	Columns                   // Side by side columns of equal width
+	Rows                      // Stacked rows of equal height
+	MasterStack               // Master tile on the left, remaining tiles stacked on the right
+	Spiral                    // Each tile takes half of the remaining area, turning clockwise
+)
+
+// DefaultMasterRatio is the master tile width fraction used by MasterStack
+// when Options.MasterRatio is not in the (0, 1) range
+const DefaultMasterRatio = 0.5
+
+// Options specifies spacing and proportions of the tiles
+type Options struct {
+	Gap         int     // Distance between adjacent tiles in logical units, negative values are treated as 0
+	Margin      int     // Distance between tiles and work area edges in logical units, negative values are treated as 0
+	MasterRatio float64 // Master tile width fraction for MasterStack
+}
+
+// Tile splits the work area of a monitor into count tiles using the given layout.
+// Gap and margin are converted to screen units with the monitor's scale factor.
+// Tiles are listed in layout order (row-major for Grid) and never overlap;
+// remainder pixels are distributed one by one to the leading tiles so that
+// tiles and gaps cover the work area minus margins exactly.
+// If the work area is too small to give every tile at least one screen unit
+// along a split direction, gaps are dropped and the trailing tiles are empty
+// (zero width or height); limit count to what the work area can hold to avoid them.
+// Returns nil if m is nil or count is not positive.
+func Tile(m *types.Monitor, layout Layout, count int, opts Options) []types.Rect {
+	if m == nil || count <= 0 {
+		return nil
+	}
+
+	margin := max(0, int(float64(opts.Margin)*m.Scale))
+	gap := max(0, int(float64(opts.Gap)*m.Scale))
+	area := types.Rect{
+		Left:   m.WorkArea.Left + margin,
+		Top:    m.WorkArea.Top + margin,
+		Right:  m.WorkArea.Right - margin,
+		Bottom: m.WorkArea.Bottom - margin,
+	}
+	if area.Right < area.Left {
+		area.Left, area.Right = m.WorkArea.Left, m.WorkArea.Right
+	}
+	if area.Bottom < area.Top {
+		area.Top, area.Bottom = m.WorkArea.Top, m.WorkArea.Bottom
+	}
+
+	switch layout {
+	case Columns:
+		return tileColumns(area, count, gap)
+	case Rows:
+		return tileRows(area, count, gap)
+	case MasterStack:
+		return tileMasterStack(area, count, gap, opts.MasterRatio)
+	case Spiral:
+		return tileSpiral(area, count, gap)
+	default:
+		return tileGrid(area, count, gap)
+	}
+}
+
+// tileGrid arranges tiles in a near-square grid
+func tileGrid(area types.Rect, count, gap int) []types.Rect {
+	cols := int(math.Ceil(math.Sqrt(float64(count))))
+	rows := (count + cols - 1) / cols
+
+	tiles := make([]types.Rect, 0, count)
+	for i, row := range tileRows(area, rows, gap) {
+		n := cols
+		if i == rows-1 {
+			n = count - cols*(rows-1)
+		}
+		tiles = append(tiles, tileColumns(row, n, gap)...)
+	}
+	return tiles
+}
+
+// tileColumns splits the area horizontally into count columns
+func tileColumns(area types.Rect, count, gap int) []types.Rect {
+	tiles := make([]types.Rect, 0, count)
+	for _, s := range splitSpan(area.Left, area.Right, count, gap) {
+		tiles = append(tiles, types.Rect{Left: s[0], Top: area.Top, Right: s[1], Bottom: area.Bottom})
+	}
+	return tiles
+}
+
+// tileRows splits the area vertically into count rows
+func tileRows(area types.Rect, count, gap int) []types.Rect {
+	tiles := make([]types.Rect, 0, count)
+	for _, s := range splitSpan(area.Top, area.Bottom, count, gap) {
+		tiles = append(tiles, types.Rect{Left: area.Left, Top: s[0], Right: area.Right, Bottom: s[1]})
+	}
+	return tiles
+}
+
+// tileMasterStack places the master tile on the left and stacks
+// the remaining tiles in a column on the right
+func tileMasterStack(area types.Rect, count, gap int, ratio float64) []types.Rect {
+	if count == 1 {
+		return []types.Rect{area}
+	}
+	if ratio <= 0 || ratio >= 1 {
+		ratio = DefaultMasterRatio
+	}
+
+	avail := area.Right - area.Left - gap
+	if avail < 2 {
+		gap = 0
+		avail = area.Right - area.Left
+	}
+	masterWidth := int(float64(avail) * ratio)
+
+	master := area
+	master.Right = area.Left + masterWidth
+	stack := area
+	stack.Left = master.Right + gap
+	return append([]types.Rect{master}, tileRows(stack, count-1, gap)...)
+}
+
+// tileSpiral gives each tile half of the remaining area, taking the left,
+// top, right and bottom halves in turn; the last tile takes what is left
+func tileSpiral(area types.Rect, count, gap int) []types.Rect {
+	tiles := make([]types.Rect, 0, count)
+	rest := area
+	for i := 0; i < count-1; i++ {
+		tile := rest
+		switch i % 4 {
+		case 0: // left
+			s := splitSpan(rest.Left, rest.Right, 2, gap)
+			tile.Right, rest.Left = s[0][1], s[1][0]
+		case 1: // top
+			s := splitSpan(rest.Top, rest.Bottom, 2, gap)
+			tile.Bottom, rest.Top = s[0][1], s[1][0]
+		case 2: // right
+			s := splitSpan(rest.Left, rest.Right, 2, gap)
+			tile.Left, rest.Right = s[1][0], s[0][1]
+		case 3: // bottom
+			s := splitSpan(rest.Top, rest.Bottom, 2, gap)
+			tile.Top, rest.Bottom = s[1][0], s[0][1]
+		}
+		tiles = append(tiles, tile)
+	}
+	return append(tiles, rest)
+}
+
+// splitSpan splits [lo, hi) into count spans separated by gap.
+// The first (hi-lo-gaps) % count spans are one unit larger than the rest.
+// If the gaps do not leave at least one unit per span, gaps are dropped.
+func splitSpan(lo, hi, count, gap int) [][2]int {
+	avail := hi - lo - gap*(count-1)
+	if avail < count {
+		gap = 0
+		avail = hi - lo
+	}
+	base := avail / count
+	rem := avail % count
+
+	spans := make([][2]int, count)
+	pos := lo
+	for i := range spans {
+		size := base
+		if i < rem {
+			size++
+		}
+		spans[i] = [2]int{pos, pos + size}
+		pos += size + gap
+	}
+	return spans
+}
@@ -0,0 +1,203 @@
+package tiling
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/adnsv/multimon/types"
+)
+
+type Rect = types.Rect
+
+func TestTile(t *testing.T) {
+	monitor := &types.Monitor{
+		Bounds:   Rect{0, 0, 1920, 1080},
+		WorkArea: Rect{0, 0, 1920, 1040},
+		Scale:    1.0,
+	}
+	hiDPI := &types.Monitor{
+		Bounds:   Rect{0, 0, 1000, 600},
+		WorkArea: Rect{0, 0, 1000, 600},
+		Scale:    2.0,
+	}
+
+	tests := []struct {
+		name    string
+		monitor *types.Monitor
+		layout  Layout
+		count   int
+		opts    Options
+		want    []Rect
+	}{
+		{
+			name:    "single tile fills work area",
+			monitor: monitor,
+			layout:  Grid,
+			count:   1,
+			want:    []Rect{{0, 0, 1920, 1040}},
+		},
+		{
+			name:    "columns with remainder",
+			monitor: monitor,
+			layout:  Columns,
+			count:   7,
+			// 1920 = 6*274 + 276: first 2 columns get one extra pixel
+			want: []Rect{
+				{0, 0, 275, 1040}, {275, 0, 550, 1040}, {550, 0, 824, 1040}, {824, 0, 1098, 1040},
+				{1098, 0, 1372, 1040}, {1372, 0, 1646, 1040}, {1646, 0, 1920, 1040},
+			},
+		},
+		{
+			name:    "rows with gap and margin",
+			monitor: monitor,
+			layout:  Rows,
+			count:   3,
+			opts:    Options{Gap: 10, Margin: 5},
+			// 1030 - 20 = 1010 = 3*336 + 2
+			want: []Rect{{5, 5, 1915, 342}, {5, 352, 1915, 689}, {5, 699, 1915, 1035}},
+		},
+		{
+			name:    "grid stretches last row",
+			monitor: monitor,
+			layout:  Grid,
+			count:   5,
+			want: []Rect{
+				{0, 0, 640, 520}, {640, 0, 1280, 520}, {1280, 0, 1920, 520},
+				{0, 520, 960, 1040}, {960, 520, 1920, 1040},
+			},
+		},
+		{
+			name:    "grid square",
+			monitor: monitor,
+			layout:  Grid,
+			count:   4,
+			opts:    Options{Gap: 20},
+			want: []Rect{
+				{0, 0, 950, 510}, {970, 0, 1920, 510},
+				{0, 530, 950, 1040}, {970, 530, 1920, 1040},
+			},
+		},
+		{
+			name:    "gap and margin are scaled",
+			monitor: hiDPI,
+			layout:  Columns,
+			count:   2,
+			opts:    Options{Gap: 10, Margin: 10},
+			want:    []Rect{{20, 20, 490, 580}, {510, 20, 980, 580}},
+		},
+		{
+			name:    "master stack default ratio",
+			monitor: monitor,
+			layout:  MasterStack,
+			count:   3,
+			opts:    Options{Gap: 10},
+			want:    []Rect{{0, 0, 955, 1040}, {965, 0, 1920, 515}, {965, 525, 1920, 1040}},
+		},
+		{
+			name:    "master stack custom ratio",
+			monitor: monitor,
+			layout:  MasterStack,
+			count:   2,
+			opts:    Options{MasterRatio: 0.6},
+			want:    []Rect{{0, 0, 1152, 1040}, {1152, 0, 1920, 1040}},
+		},
+		{
+			name:    "master stack single tile",
+			monitor: monitor,
+			layout:  MasterStack,
+			count:   1,
+			want:    []Rect{{0, 0, 1920, 1040}},
+		},
+		{
+			name:    "spiral",
+			monitor: monitor,
+			layout:  Spiral,
+			count:   5,
+			want: []Rect{
+				{0, 0, 960, 1040},
+				{960, 0, 1920, 520},
+				{1440, 520, 1920, 1040},
+				{960, 780, 1440, 1040},
+				{960, 520, 1440, 780},
+			},
+		},
+		{
+			name:    "gaps dropped when too large",
+			monitor: &types.Monitor{WorkArea: Rect{0, 0, 10, 10}, Scale: 1.0},
+			layout:  Columns,
+			count:   3,
+			opts:    Options{Gap: 5},
+			want:    []Rect{{0, 0, 4, 10}, {4, 0, 7, 10}, {7, 0, 10, 10}},
+		},
+		{
+			name:    "negative gap and margin are ignored",
+			monitor: &types.Monitor{WorkArea: Rect{0, 0, 10, 10}, Scale: 1.0},
+			layout:  Columns,
+			count:   2,
+			opts:    Options{Gap: -2, Margin: -3},
+			want:    []Rect{{0, 0, 5, 10}, {5, 0, 10, 10}},
+		},
+		{
+			name:    "more tiles than screen units",
+			monitor: &types.Monitor{WorkArea: Rect{0, 0, 3, 10}, Scale: 1.0},
+			layout:  Columns,
+			count:   5,
+			want:    []Rect{{0, 0, 1, 10}, {1, 0, 2, 10}, {2, 0, 3, 10}, {3, 0, 3, 10}, {3, 0, 3, 10}},
+		},
+		{
+			name:    "nil monitor",
+			monitor: nil,
+			layout:  Grid,
+			count:   3,
+			want:    nil,
+		},
+		{
+			name:    "zero count",
+			monitor: monitor,
+			layout:  Grid,
+			count:   0,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tile(tt.monitor, tt.layout, tt.count, tt.opts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTileNoOverlap(t *testing.T) {
+	monitor := &types.Monitor{
+		Bounds:   Rect{-1280, 0, 0, 1024},
+		WorkArea: Rect{-1280, 0, 0, 997},
+		Scale:    1.25,
+	}
+	opts := Options{Gap: 7, Margin: 3, MasterRatio: 0.37}
+	area := Rect{-1277, 3, -3, 994}
+
+	for _, layout := range []Layout{Grid, Columns, Rows, MasterStack, Spiral} {
+		for count := 1; count <= 13; count++ {
+			tiles := Tile(monitor, layout, count, opts)
+			if len(tiles) != count {
+				t.Fatalf("layout %d, count %d: got %d tiles", layout, count, len(tiles))
+			}
+			for i, a := range tiles {
+				if a.Right <= a.Left || a.Bottom <= a.Top {
+					t.Errorf("layout %d, count %d: empty tile %v", layout, count, a)
+				}
+				if a.Left < area.Left || a.Top < area.Top || a.Right > area.Right || a.Bottom > area.Bottom {
+					t.Errorf("layout %d, count %d: tile %v outside %v", layout, count, a, area)
+				}
+				for _, b := range tiles[i+1:] {
+					if a.Left < b.Right && b.Left < a.Right && a.Top < b.Bottom && b.Top < a.Bottom {
+						t.Errorf("layout %d, count %d: tiles %v and %v overlap", layout, count, a, b)
+					}
+				}
+			}
+		}
+	}
+}
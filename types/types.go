@@ -15,4 +15,22 @@ type Monitor struct {
 	Bounds   Rect    // Monitor bounds in screen units
 	WorkArea Rect    // Work area (excluding taskbar, etc.) in screen units
 	Scale    float64 // Scale factor (1.0 = 100%, 2.0 = 200%, etc.)
+
+	// Identity of the monitor, empty if the platform does not provide it.
+	Name         string // Connector or device name (e.g. "DP-1", "\\.\DISPLAY1"), empty on macOS
+	Manufacturer string // Manufacturer from EDID (e.g. "GSM", "Dell Inc.")
+	Model        string // Model name or product code from EDID
+	Serial       string // Serial number from EDID, currently only filled on macOS
+
+	// Physical size of the display area in millimeters, 0 if unknown
+	WidthMM  int
+	HeightMM int
+
+	// UsableAreas describe a non-rectangular usable region (bounds minus
+	// panels and docks) as a list of possibly overlapping rects in screen units.
+	// Areas are clipped to WorkArea when used. Platforms do not fill this field;
+	// applications that know the struts can set it with multimon.UsableAreasFromStruts.
+	// If empty, the usable region is the WorkArea itself.
+	// Note that the slice field makes Monitor non-comparable with ==.
+	UsableAreas []Rect
 }
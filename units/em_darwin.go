@@ -24,7 +24,7 @@ int getSystemFontEmHeight(void) {
 */
 import "C"
 
-// GetEmHeight returns the system font em-height in points.
+// GetEmHeight returns the system font em-height in points (logical units).
 // Uses the macOS system font (San Francisco).
 func GetEmHeight() int {
 	h := int(C.getSystemFontEmHeight())
@@ -75,8 +75,9 @@ int getSystemFontEmHeight(void) {
 */
 import "C"
 
-// GetEmHeight returns the system font em-height in pixels.
-// Uses GtkSettings to get the actual system UI font.
+// GetEmHeight returns the system font em-height in logical units.
+// Uses GtkSettings to get the actual system UI font; Pango metrics are not
+// multiplied by the GDK scale factor.
 func GetEmHeight() int {
 	h := int(C.getSystemFontEmHeight())
 	if h <= 0 {
@@ -14,6 +14,7 @@ var (
 	procSelectObject         = gdi32.NewProc("SelectObject")
 	procGetTextMetricsW      = gdi32.NewProc("GetTextMetricsW")
 	procDeleteObject         = gdi32.NewProc("DeleteObject")
+	procGetDeviceCaps        = gdi32.NewProc("GetDeviceCaps")
 	procGetDC                = user32.NewProc("GetDC")
 	procReleaseDC            = user32.NewProc("ReleaseDC")
 	procSystemParametersInfo = user32.NewProc("SystemParametersInfoW")
@@ -22,6 +23,7 @@ var (
 const (
 	SPI_GETNONCLIENTMETRICS = 0x0029
 	LF_FACESIZE             = 32
+	LOGPIXELSY              = 90
 )
 
 // LOGFONTW structure
@@ -86,8 +88,11 @@ type textMetricW struct {
 	TmCharSet          uint8
 }
 
-// GetEmHeight returns the system font em-height in pixels.
+// GetEmHeight returns the system font em-height in logical units (pixels at 96 DPI).
 // Uses SystemParametersInfo to get the actual system UI font (lfMessageFont).
+// The font metrics are in pixels at the system DPI, so they are converted
+// back to 96 DPI. Earlier versions returned pixels at the system DPI; use
+// ResolveContext.Scale to convert the result to device pixels.
 func GetEmHeight() int {
 	// Get non-client metrics to retrieve the system message font
 	var ncm nonClientMetricsW
@@ -127,5 +132,9 @@ func GetEmHeight() int {
 		return 16
 	}
 
-	return int(tm.TmHeight)
+	dpi, _, _ := procGetDeviceCaps.Call(hdc, LOGPIXELSY)
+	if int32(dpi) <= 0 {
+		return int(tm.TmHeight)
+	}
+	return (int(tm.TmHeight)*96 + int(dpi)/2) / int(dpi)
 }
@@ -1,48 +1,66 @@
 package units
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 )
 
+// ErrInvalidDimension is returned when a dimension string cannot be parsed
+var ErrInvalidDimension = errors.New("invalid dimension")
+
 // ParseDimension parses a dimension string like "1024", "1024px", "60em", or "80%".
 // Returns a zero dimension if parsing fails.
 func ParseDimension(s string) Dimension {
-	s = strings.TrimSpace(s)
-	if s == "" {
+	d, err := ParseDimensionStrict(s)
+	if err != nil {
 		return Dimension{}
 	}
+	return d
+}
 
-	// Check for em suffix
-	if strings.HasSuffix(s, "em") {
-		if v, err := strconv.ParseFloat(strings.TrimSuffix(s, "em"), 64); err == nil {
-			return Dimension{Value: v, Unit: Em}
-		}
-		return Dimension{}
+// ParseDimensionStrict parses a dimension string in the formats of ParseDimension.
+// An empty string is a zero dimension.
+// Returns ErrInvalidDimension if parsing fails.
+func ParseDimensionStrict(s string) (Dimension, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Dimension{}, nil
 	}
 
-	// Check for percent suffix
-	if strings.HasSuffix(s, "%") {
-		if v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64); err == nil {
-			return Dimension{Value: v, Unit: Percent}
-		}
-		return Dimension{}
+	unit := Pixel
+	num := s
+	switch {
+	case strings.HasSuffix(s, "em"):
+		unit = Em
+		num = strings.TrimSuffix(s, "em")
+	case strings.HasSuffix(s, "%"):
+		unit = Percent
+		num = strings.TrimSuffix(s, "%")
+	case strings.HasSuffix(s, "px"):
+		num = strings.TrimSuffix(s, "px")
 	}
 
-	// Check for px suffix (explicit pixels)
-	if strings.HasSuffix(s, "px") {
-		if v, err := strconv.ParseFloat(strings.TrimSuffix(s, "px"), 64); err == nil {
-			return Dimension{Value: v, Unit: Pixel}
-		}
-		return Dimension{}
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return Dimension{}, fmt.Errorf("%w: %q", ErrInvalidDimension, s)
 	}
+	return Dimension{Value: v, Unit: unit}, nil
+}
 
-	// Try as pixel value (plain number)
-	if v, err := strconv.ParseFloat(s, 64); err == nil {
-		return Dimension{Value: v, Unit: Pixel}
+// FormatDimension formats a dimension in the formats accepted by ParseDimensionStrict.
+// Unlike String, the value is formatted without loss of precision
+// (e.g., "33.333333333333336%").
+func FormatDimension(d Dimension) string {
+	v := strconv.FormatFloat(d.Value, 'g', -1, 64)
+	switch d.Unit {
+	case Em:
+		v += "em"
+	case Percent:
+		v += "%"
 	}
-
-	return Dimension{}
+	return v
 }
 
 // ParseDimensionWithDefault parses a dimension string, returning defaultVal if parsing fails.
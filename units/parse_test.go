@@ -1,6 +1,9 @@
 package units
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestParseDimension(t *testing.T) {
 	tests := []struct {
@@ -171,3 +174,41 @@ func TestDimensionConstructors(t *testing.T) {
 		}
 	})
 }
+
+func TestDimensionText(t *testing.T) {
+	tests := []struct {
+		dim  Dimension
+		text string
+	}{
+		{Dimension{Value: 1024, Unit: Pixel}, "1024"},
+		{Dimension{Value: 100.5, Unit: Pixel}, "100.5"},
+		{Dimension{Value: 2.25, Unit: Em}, "2.25em"},
+		{Dimension{Value: 50, Unit: Percent}, "50%"},
+		{Dimension{Value: 100.0 / 3, Unit: Percent}, "33.333333333333336%"},
+		{Dimension{Value: -10, Unit: Pixel}, "-10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := FormatDimension(tt.dim); got != tt.text {
+				t.Errorf("FormatDimension() = %q, want %q", got, tt.text)
+			}
+
+			d, err := ParseDimensionStrict(tt.text)
+			if err != nil {
+				t.Fatalf("ParseDimensionStrict(%q) error: %v", tt.text, err)
+			}
+			if d != tt.dim {
+				t.Errorf("ParseDimensionStrict(%q) = %+v, want %+v", tt.text, d, tt.dim)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{"invalid", "em", "12abc"} {
+			if _, err := ParseDimensionStrict(s); !errors.Is(err, ErrInvalidDimension) {
+				t.Errorf("ParseDimensionStrict(%q) error = %v, want %v", s, err, ErrInvalidDimension)
+			}
+		}
+	})
+}
@@ -1,26 +1,30 @@
 package units
 
-// ResolveWidth resolves a dimension as a width value in pixels
+// ResolveWidth resolves a dimension as a width value in pixels.
+// Pixel and em values are multiplied by the context's scale factor;
+// percentages are relative to the work area width.
 func (d Dimension) ResolveWidth(ctx ResolveContext) int {
 	switch d.Unit {
 	case Em:
-		return int(d.Value * float64(ctx.EmHeight))
+		return int(d.Value * float64(ctx.EmHeight) * ctx.scale())
 	case Percent:
 		return int(d.Value / 100.0 * float64(ctx.WorkArea.Width))
 	default:
-		return int(d.Value)
+		return int(d.Value * ctx.scale())
 	}
 }
 
-// ResolveHeight resolves a dimension as a height value in pixels
+// ResolveHeight resolves a dimension as a height value in pixels.
+// Pixel and em values are multiplied by the context's scale factor;
+// percentages are relative to the work area height.
 func (d Dimension) ResolveHeight(ctx ResolveContext) int {
 	switch d.Unit {
 	case Em:
-		return int(d.Value * float64(ctx.EmHeight))
+		return int(d.Value * float64(ctx.EmHeight) * ctx.scale())
 	case Percent:
 		return int(d.Value / 100.0 * float64(ctx.WorkArea.Height))
 	default:
-		return int(d.Value)
+		return int(d.Value * ctx.scale())
 	}
 }
 
@@ -190,4 +190,18 @@ func TestResolveWithDifferentContexts(t *testing.T) {
 			t.Errorf("10em with EmHeight=20 = %d, want 200", w20)
 		}
 	})
+
+	t.Run("scale factor", func(t *testing.T) {
+		ctx := ResolveContext{EmHeight: 16, WorkArea: WorkArea{Width: 1920, Height: 1080}, Scale: 1.5}
+
+		if w := (Dimension{Value: 10, Unit: Em}).ResolveWidth(ctx); w != 240 {
+			t.Errorf("10em at 1.5x = %d, want 240", w)
+		}
+		if h := (Dimension{Value: 100, Unit: Pixel}).ResolveHeight(ctx); h != 150 {
+			t.Errorf("100px at 1.5x = %d, want 150", h)
+		}
+		if w := (Dimension{Value: 50, Unit: Percent}).ResolveWidth(ctx); w != 960 {
+			t.Errorf("50%% at 1.5x = %d, want 960 (percentages are not scaled)", w)
+		}
+	})
 }
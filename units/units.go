@@ -65,6 +65,15 @@ type WorkArea struct {
 
 // ResolveContext contains all context needed to resolve dimensions to pixels
 type ResolveContext struct {
-	EmHeight int      // System em-height in pixels
-	WorkArea WorkArea // Monitor work area dimensions
+	EmHeight int      // System em-height in logical units, see GetEmHeight
+	WorkArea WorkArea // Monitor work area dimensions in pixels
+	Scale    float64  // Scale factor applied to pixel and em values, 1.0 if 0
+}
+
+// scale returns the scale factor of the context, 1.0 if not set
+func (ctx ResolveContext) scale() float64 {
+	if ctx.Scale > 0 {
+		return ctx.Scale
+	}
+	return 1.0
 }
@@ -0,0 +1,53 @@
+package multimon
+
+// UsableAreasFromStruts calculates the usable areas of a monitor from the
+// rects reserved by panels and docks (struts), for use as Monitor.UsableAreas.
+// The result is the list of maximal rects within bounds that do not
+// intersect any strut. Returns nil if struts cover the whole monitor.
+func UsableAreasFromStruts(bounds Rect, struts []Rect) []Rect {
+	return maximalEmptyRects(bounds, struts)
+}
+
+// selectUsableArea selects the usable area of monitor m that best
+// accommodates the window. Areas are clipped to the work area first.
+// Areas that can hold the whole window are preferred, then areas with the
+// largest overlap with the window, then the largest areas.
+// Returns the work area if none of the areas intersects it.
+func selectUsableArea(m *Monitor, window Rect) Rect {
+	best := m.WorkArea
+	bestFits, bestOverlap, bestArea := false, -1, 0
+	width := window.Right - window.Left
+	height := window.Bottom - window.Top
+
+	for _, a := range m.UsableAreas {
+		r, ok := intersectRect(a, m.WorkArea)
+		if !ok {
+			continue
+		}
+		fits := r.Right-r.Left >= width && r.Bottom-r.Top >= height
+		overlap := getOverlapArea(window, r)
+		area := (r.Right - r.Left) * (r.Bottom - r.Top)
+
+		better := bestOverlap < 0
+		switch {
+		case better:
+		case fits != bestFits:
+			better = fits
+		case overlap != bestOverlap:
+			better = overlap > bestOverlap
+		default:
+			better = area > bestArea
+		}
+		if better {
+			best, bestFits, bestOverlap, bestArea = r, fits, overlap, area
+		}
+	}
+	return best
+}
+
+// usableAreaFits checks if monitor m has a usable area that can hold a window
+// of the given size in screen units, see selectUsableArea
+func usableAreaFits(m *Monitor, width, height int) bool {
+	r := selectUsableArea(m, Rect{Right: width, Bottom: height})
+	return r.Right-r.Left >= width && r.Bottom-r.Top >= height
+}
@@ -0,0 +1,170 @@
+package multimon
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUsableAreasFromStruts(t *testing.T) {
+	bounds := Rect{0, 0, 1920, 1080}
+
+	tests := []struct {
+		name   string
+		struts []Rect
+		want   []Rect
+	}{
+		{
+			name: "no struts",
+			want: []Rect{{0, 0, 1920, 1080}},
+		},
+		{
+			name:   "full-width taskbar",
+			struts: []Rect{{0, 1040, 1920, 1080}},
+			want:   []Rect{{0, 0, 1920, 1040}},
+		},
+		{
+			name:   "partial docks on two edges",
+			struts: []Rect{{1420, 0, 1920, 300}, {0, 880, 400, 1080}},
+			want: []Rect{
+				{400, 0, 1420, 1080},
+				{0, 0, 1420, 880},
+				{400, 300, 1920, 1080},
+				{0, 300, 1920, 880},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UsableAreasFromStruts(bounds, tt.struts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFitToUsableAreas(t *testing.T) {
+	docks := UsableAreasFromStruts(Rect{0, 0, 1920, 1080}, []Rect{{1420, 0, 1920, 300}, {0, 880, 400, 1080}})
+	monitor := func(areas []Rect) *Monitor {
+		return &Monitor{
+			Bounds:      Rect{0, 0, 1920, 1080},
+			WorkArea:    Rect{0, 0, 1920, 1080},
+			Scale:       1.0,
+			UsableAreas: areas,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		monitor *Monitor
+		mode    FitMode
+		window  Rect
+		want    Rect
+	}{
+		{
+			name:    "window within usable area",
+			monitor: monitor(docks),
+			mode:    FitModeWorkArea,
+			window:  Rect{100, 100, 900, 700},
+			want:    Rect{100, 100, 900, 700},
+		},
+		{
+			name:    "window under top-right dock",
+			monitor: monitor(docks),
+			mode:    FitModeWorkArea,
+			window:  Rect{1500, 100, 1900, 500},
+			want:    Rect{1500, 300, 1900, 700},
+		},
+		{
+			name:    "window over bottom-left dock",
+			monitor: monitor(docks),
+			mode:    FitModeWorkArea,
+			window:  Rect{100, 700, 700, 1000},
+			want:    Rect{100, 580, 700, 880},
+		},
+		{
+			name:    "wide window fits only the middle band",
+			monitor: monitor(docks),
+			mode:    FitModeWorkArea,
+			window:  Rect{0, 400, 1800, 800},
+			want:    Rect{0, 400, 1800, 800},
+		},
+		{
+			name:    "bounds mode ignores usable areas",
+			monitor: monitor(docks),
+			mode:    FitModeBounds,
+			window:  Rect{1500, 100, 1900, 500},
+			want:    Rect{1500, 100, 1900, 500},
+		},
+		{
+			name:    "monitor without usable areas",
+			monitor: monitor(nil),
+			mode:    FitModeWorkArea,
+			window:  Rect{1500, 100, 1900, 500},
+			want:    Rect{1500, 100, 1900, 500},
+		},
+		{
+			name:    "areas are clipped to the work area",
+			monitor: monitor([]Rect{{1000, -500, 2500, 500}}),
+			mode:    FitModeWorkArea,
+			window:  Rect{1700, 100, 2100, 500},
+			want:    Rect{1520, 100, 1920, 500},
+		},
+		{
+			name:    "areas outside the work area are ignored",
+			monitor: monitor([]Rect{{2000, 0, 2500, 500}}),
+			mode:    FitModeWorkArea,
+			window:  Rect{1500, 100, 1900, 500},
+			want:    Rect{1500, 100, 1900, 500},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := FitToMonitor(tt.monitor, tt.mode, tt.window, 0)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFitToNearestMonitorUsableAreas(t *testing.T) {
+	// The left monitor has a large work area, but a dock splits it into
+	// areas that cannot hold the minimum size
+	monitors := []Monitor{
+		{
+			Bounds:      Rect{0, 0, 1920, 1080},
+			WorkArea:    Rect{0, 0, 1920, 1080},
+			Scale:       1.0,
+			UsableAreas: UsableAreasFromStruts(Rect{0, 0, 1920, 1080}, []Rect{{900, 0, 1020, 1080}}),
+		},
+		{
+			Bounds:   Rect{1920, 0, 3840, 1080},
+			WorkArea: Rect{1920, 0, 3840, 1080},
+			Scale:    1.0,
+		},
+	}
+
+	got, _, err := FitToNearestMonitor(monitors, FitModeWorkArea, Rect{100, 100, 1100, 700}, 0, 1000, 600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (Rect{1920, 100, 2920, 700}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Without the dock the left monitor is used
+	monitors[0].UsableAreas = nil
+	got, _, err = FitToNearestMonitor(monitors, FitModeWorkArea, Rect{100, 100, 1100, 700}, 0, 1000, 600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := (Rect{100, 100, 1100, 700}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
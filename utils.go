@@ -71,6 +71,26 @@ func fitRectDimension(pos, size, boundsMin, boundsMax int) (newPos, newSize int)
 	return pos, size
 }
 
+// centerRect moves a rectangle so that its center matches the center of bounds.
+// Rectangle size is not changed.
+func centerRect(r, bounds Rect) Rect {
+	width := r.Right - r.Left
+	height := r.Bottom - r.Top
+	centerX := (bounds.Left + bounds.Right) / 2
+	centerY := (bounds.Top + bounds.Bottom) / 2
+	return Rect{
+		Left:   centerX - width/2,
+		Top:    centerY - height/2,
+		Right:  centerX + (width+1)/2,
+		Bottom: centerY + (height+1)/2,
+	}
+}
+
+// isSameSize checks if two rectangles have the same width and height
+func isSameSize(a, b Rect) bool {
+	return a.Right-a.Left == b.Right-b.Left && a.Bottom-a.Top == b.Bottom-b.Top
+}
+
 // min returns the smaller of two integers
 func min(a, b int) int {
 	if a < b {
@@ -86,3 +106,11 @@ func max(a, b int) int {
 	}
 	return b
 }
+
+// abs returns the absolute value of an integer
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
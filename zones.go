@@ -0,0 +1,225 @@
+package multimon
+
+import (
+	"encoding/json"
+
+	"github.com/adnsv/multimon/units"
+)
+
+// Zone is a named snap zone within a monitor's work area. Edges are offsets
+// from the left and top edges of the work area:
+// - Percentages are relative to the work area width (Left, Right) or height (Top, Bottom)
+// - Pixels and em units are logical units, converted with the monitor's scale
+//
+// For example, the right two-thirds of the work area is
+// {Left: 33.333%, Top: 0, Right: 100%, Bottom: 100%}.
+//
+// In JSON, edges are strings in the formats of units.ParseDimensionStrict,
+// e.g. {"name": "right", "left": "33.333%", "top": "0", "right": "100%", "bottom": "100%"}.
+type Zone struct {
+	Name   string
+	Left   units.Dimension
+	Top    units.Dimension
+	Right  units.Dimension
+	Bottom units.Dimension
+}
+
+// zoneJSON is the JSON representation of a Zone
+type zoneJSON struct {
+	Name   string `json:"name"`
+	Left   string `json:"left"`
+	Top    string `json:"top"`
+	Right  string `json:"right"`
+	Bottom string `json:"bottom"`
+}
+
+// MarshalJSON implements json.Marshaler, formatting edges with units.FormatDimension
+func (z Zone) MarshalJSON() ([]byte, error) {
+	return json.Marshal(zoneJSON{
+		Name:   z.Name,
+		Left:   units.FormatDimension(z.Left),
+		Top:    units.FormatDimension(z.Top),
+		Right:  units.FormatDimension(z.Right),
+		Bottom: units.FormatDimension(z.Bottom),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing edges with units.ParseDimensionStrict.
+// Returns units.ErrInvalidDimension if an edge cannot be parsed.
+func (z *Zone) UnmarshalJSON(data []byte) error {
+	var j zoneJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	var r Zone
+	r.Name = j.Name
+	for _, e := range []struct {
+		text string
+		dim  *units.Dimension
+	}{
+		{j.Left, &r.Left},
+		{j.Top, &r.Top},
+		{j.Right, &r.Right},
+		{j.Bottom, &r.Bottom},
+	} {
+		d, err := units.ParseDimensionStrict(e.text)
+		if err != nil {
+			return err
+		}
+		*e.dim = d
+	}
+	*z = r
+	return nil
+}
+
+// Orientation restricts a zone set to landscape or portrait monitors
+type Orientation string
+
+const (
+	OrientationAny       Orientation = ""          // Any monitor
+	OrientationLandscape Orientation = "landscape" // Work area wider than tall (or square)
+	OrientationPortrait  Orientation = "portrait"  // Work area taller than wide
+)
+
+// ZoneSet is a set of zones applicable to monitors matching its
+// orientation and minimum work area size
+type ZoneSet struct {
+	Name        string      `json:"name"`
+	Orientation Orientation `json:"orientation,omitempty"`
+	MinWidth    int         `json:"min_width,omitempty"`  // Minimum work area width in logical units
+	MinHeight   int         `json:"min_height,omitempty"` // Minimum work area height in logical units
+	Zones       []Zone      `json:"zones"`
+}
+
+// ZoneLayout is a list of zone sets, the first set matching a monitor is used.
+// List more specific sets (e.g. large or portrait monitors) before generic ones.
+type ZoneLayout struct {
+	Sets []ZoneSet `json:"sets"`
+}
+
+// ZoneRect is a zone resolved to screen units
+type ZoneRect struct {
+	Name string
+	Rect Rect
+}
+
+// Matches returns true if the zone set applies to monitor m
+func (s ZoneSet) Matches(m *Monitor) bool {
+	if m == nil {
+		return false
+	}
+	width := m.WorkArea.Right - m.WorkArea.Left
+	height := m.WorkArea.Bottom - m.WorkArea.Top
+
+	switch s.Orientation {
+	case OrientationLandscape:
+		if width < height {
+			return false
+		}
+	case OrientationPortrait:
+		if width >= height {
+			return false
+		}
+	}
+
+	return width >= int(float64(s.MinWidth)*m.Scale) &&
+		height >= int(float64(s.MinHeight)*m.Scale)
+}
+
+// Resolve converts the zones to screen units on monitor m.
+// Zones are clipped to the work area; zones that resolve to an empty rect are omitted.
+// Returns nil if m is nil.
+func (s ZoneSet) Resolve(m *Monitor) []ZoneRect {
+	return s.resolve(m, units.GetEmHeight())
+}
+
+// resolve implements Resolve with the given em-height
+func (s ZoneSet) resolve(m *Monitor, emHeight int) []ZoneRect {
+	if m == nil {
+		return nil
+	}
+
+	ctx := resolveContext(m, emHeight)
+	wa := m.WorkArea
+	zones := make([]ZoneRect, 0, len(s.Zones))
+	for _, z := range s.Zones {
+		r := Rect{
+			Left:   max(wa.Left, wa.Left+z.Left.ResolveWidth(ctx)),
+			Top:    max(wa.Top, wa.Top+z.Top.ResolveHeight(ctx)),
+			Right:  min(wa.Right, wa.Left+z.Right.ResolveWidth(ctx)),
+			Bottom: min(wa.Bottom, wa.Top+z.Bottom.ResolveHeight(ctx)),
+		}
+		if r.Right <= r.Left || r.Bottom <= r.Top {
+			continue
+		}
+		zones = append(zones, ZoneRect{Name: z.Name, Rect: r})
+	}
+	return zones
+}
+
+// SelectSet returns the first zone set matching monitor m,
+// or nil if no set matches
+func (l ZoneLayout) SelectSet(m *Monitor) *ZoneSet {
+	for i := range l.Sets {
+		if l.Sets[i].Matches(m) {
+			return &l.Sets[i]
+		}
+	}
+	return nil
+}
+
+// Resolve converts the zones of the set selected for monitor m to screen units.
+// Returns nil if no set matches.
+func (l ZoneLayout) Resolve(m *Monitor) []ZoneRect {
+	return l.resolve(m, units.GetEmHeight())
+}
+
+// resolve implements Resolve with the given em-height
+func (l ZoneLayout) resolve(m *Monitor, emHeight int) []ZoneRect {
+	s := l.SelectSet(m)
+	if s == nil {
+		return nil
+	}
+	return s.resolve(m, emHeight)
+}
+
+// HitTest returns the zone under a point in screen units, looking up the
+// monitor containing the point and resolving its zone set.
+// If zones overlap, the smallest zone containing the point is returned.
+// Returns false if the point is not within a zone.
+func (l ZoneLayout) HitTest(monitors []Monitor, x, y int) (ZoneRect, *Monitor, bool) {
+	return l.hitTest(monitors, x, y, units.GetEmHeight())
+}
+
+// hitTest implements HitTest with the given em-height
+func (l ZoneLayout) hitTest(monitors []Monitor, x, y, emHeight int) (ZoneRect, *Monitor, bool) {
+	m := FindMonitorFromScreenPoint(monitors, x, y, DefaultMonitorNull)
+	if m == nil {
+		return ZoneRect{}, nil, false
+	}
+	z, ok := HitTestZone(l.resolve(m, emHeight), x, y)
+	if !ok {
+		return ZoneRect{}, nil, false
+	}
+	return z, m, true
+}
+
+// HitTestZone returns the smallest zone containing a point in screen units.
+// Returns false if the point is not within any zone.
+func HitTestZone(zones []ZoneRect, x, y int) (ZoneRect, bool) {
+	var best ZoneRect
+	bestArea := -1
+	for _, z := range zones {
+		r := z.Rect
+		if x < r.Left || x >= r.Right || y < r.Top || y >= r.Bottom {
+			continue
+		}
+		area := (r.Right - r.Left) * (r.Bottom - r.Top)
+		if bestArea < 0 || area < bestArea {
+			best = z
+			bestArea = area
+		}
+	}
+	return best, bestArea >= 0
+}
@@ -0,0 +1,234 @@
+package multimon
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/adnsv/multimon/units"
+)
+
+var testZoneLayout = ZoneLayout{
+	Sets: []ZoneSet{
+		{
+			Name:        "portrait",
+			Orientation: OrientationPortrait,
+			Zones: []Zone{
+				{Name: "top", Right: units.Pct(100), Bottom: units.Pct(50)},
+				{Name: "bottom", Top: units.Pct(50), Right: units.Pct(100), Bottom: units.Pct(100)},
+			},
+		},
+		{
+			Name:     "wide",
+			MinWidth: 2560,
+			Zones: []Zone{
+				{Name: "left", Right: units.Pct(100.0 / 3), Bottom: units.Pct(100)},
+				{Name: "center", Left: units.Pct(100.0 / 3), Right: units.Pct(200.0 / 3), Bottom: units.Pct(100)},
+				{Name: "right", Left: units.Pct(200.0 / 3), Right: units.Pct(100), Bottom: units.Pct(100)},
+			},
+		},
+		{
+			Name: "halves",
+			Zones: []Zone{
+				{Name: "left", Right: units.Pct(50), Bottom: units.Pct(100)},
+				{Name: "right", Left: units.Pct(50), Right: units.Pct(100), Bottom: units.Pct(100)},
+				{Name: "corner", Left: units.Pixels(10), Top: units.Pixels(10), Right: units.Ems(10), Bottom: units.Ems(10)},
+			},
+		},
+	},
+}
+
+func TestZoneSetMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		monitor *Monitor
+		want    string
+	}{
+		{
+			name:    "landscape monitor",
+			monitor: &Monitor{WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+			want:    "halves",
+		},
+		{
+			name:    "portrait monitor",
+			monitor: &Monitor{WorkArea: Rect{0, 0, 1080, 1880}, Scale: 1.0},
+			want:    "portrait",
+		},
+		{
+			name:    "wide monitor",
+			monitor: &Monitor{WorkArea: Rect{0, 0, 3440, 1400}, Scale: 1.0},
+			want:    "wide",
+		},
+		{
+			name:    "minimum size is in logical units",
+			monitor: &Monitor{WorkArea: Rect{0, 0, 3840, 2100}, Scale: 2.0},
+			want:    "halves",
+		},
+		{
+			name:    "nil monitor",
+			monitor: nil,
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if s := testZoneLayout.SelectSet(tt.monitor); s != nil {
+				got = s.Name
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZoneLayoutResolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		monitor *Monitor
+		want    []ZoneRect
+	}{
+		{
+			name:    "halves with logical units",
+			monitor: &Monitor{WorkArea: Rect{1920, 40, 3840, 1080}, Scale: 1.5},
+			want: []ZoneRect{
+				{"left", Rect{1920, 40, 2880, 1080}},
+				{"right", Rect{2880, 40, 3840, 1080}},
+				{"corner", Rect{1935, 55, 2160, 280}},
+			},
+		},
+		{
+			name:    "thirds",
+			monitor: &Monitor{WorkArea: Rect{0, 0, 3440, 1400}, Scale: 1.0},
+			want: []ZoneRect{
+				{"left", Rect{0, 0, 1146, 1400}},
+				{"center", Rect{1146, 0, 2293, 1400}},
+				{"right", Rect{2293, 0, 3440, 1400}},
+			},
+		},
+		{
+			name:    "zones are clipped to work area",
+			monitor: &Monitor{WorkArea: Rect{0, 0, 100, 80}, Scale: 1.0},
+			want: []ZoneRect{
+				{"left", Rect{0, 0, 50, 80}},
+				{"right", Rect{50, 0, 100, 80}},
+				{"corner", Rect{10, 10, 100, 80}},
+			},
+		},
+		{
+			name:    "empty zones are omitted",
+			monitor: &Monitor{WorkArea: Rect{0, 0, 8, 6}, Scale: 1.0},
+			want: []ZoneRect{
+				{"left", Rect{0, 0, 4, 6}},
+				{"right", Rect{4, 0, 8, 6}},
+			},
+		},
+		{
+			name:    "nil monitor",
+			monitor: nil,
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testZoneLayout.resolve(tt.monitor, 16)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZoneLayoutHitTest(t *testing.T) {
+	monitors := []Monitor{
+		{Bounds: Rect{0, 0, 1920, 1080}, WorkArea: Rect{0, 0, 1920, 1040}, Scale: 1.0},
+		{Bounds: Rect{1920, 0, 3000, 1920}, WorkArea: Rect{1920, 0, 3000, 1880}, Scale: 1.0},
+	}
+
+	tests := []struct {
+		name       string
+		x, y       int
+		want       string
+		wantOK     bool
+		wantScreen int
+	}{
+		{name: "left half", x: 900, y: 500, want: "left", wantOK: true, wantScreen: 0},
+		{name: "right half", x: 960, y: 500, want: "right", wantOK: true, wantScreen: 0},
+		{name: "smallest overlapping zone", x: 50, y: 50, want: "corner", wantOK: true, wantScreen: 0},
+		{name: "portrait monitor", x: 2500, y: 1000, want: "bottom", wantOK: true, wantScreen: 1},
+		{name: "outside work area", x: 900, y: 1060, wantOK: false},
+		{name: "outside monitors", x: -100, y: 500, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, m, ok := testZoneLayout.hitTest(monitors, tt.x, tt.y, 16)
+			if ok != tt.wantOK {
+				t.Fatalf("got ok %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if z.Name != tt.want {
+				t.Errorf("got zone %q, want %q", z.Name, tt.want)
+			}
+			if m != &monitors[tt.wantScreen] {
+				t.Errorf("got monitor %v, want %v", m, monitors[tt.wantScreen])
+			}
+		})
+	}
+}
+
+func TestZoneLayoutJSON(t *testing.T) {
+	data, err := json.Marshal(testZoneLayout)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	var got ZoneLayout
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if !reflect.DeepEqual(got, testZoneLayout) {
+		t.Errorf("got %+v, want %+v", got, testZoneLayout)
+	}
+
+	t.Run("hand-written", func(t *testing.T) {
+		var l ZoneLayout
+		err := json.Unmarshal([]byte(`{"sets": [{"name": "main", "orientation": "landscape",
+			"zones": [{"name": "a", "left": "10px", "top": "2em", "right": "50%", "bottom": "100%"}]}]}`), &l)
+		if err != nil {
+			t.Fatalf("Unmarshal error: %v", err)
+		}
+		want := ZoneLayout{Sets: []ZoneSet{{
+			Name:        "main",
+			Orientation: OrientationLandscape,
+			Zones:       []Zone{{Name: "a", Left: units.Pixels(10), Top: units.Ems(2), Right: units.Pct(50), Bottom: units.Pct(100)}},
+		}}}
+		if !reflect.DeepEqual(l, want) {
+			t.Errorf("got %+v, want %+v", l, want)
+		}
+	})
+
+	t.Run("invalid dimension", func(t *testing.T) {
+		var l ZoneLayout
+		err := json.Unmarshal([]byte(`{"sets": [{"zones": [{"left": "wide"}]}]}`), &l)
+		if !errors.Is(err, units.ErrInvalidDimension) {
+			t.Errorf("got error %v, want %v", err, units.ErrInvalidDimension)
+		}
+	})
+
+	t.Run("dimension encoding is unchanged", func(t *testing.T) {
+		data, err := json.Marshal(units.Pct(50))
+		if err != nil {
+			t.Fatalf("Marshal error: %v", err)
+		}
+		if want := `{"Value":50,"Unit":2}`; string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+	})
+}